@@ -0,0 +1,115 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zenazn/money/decimal"
+)
+
+// Accumulator computes the sum and average of a stream of values of a single
+// currency, without requiring the values to be collected into a slice first.
+// The zero value of Accumulator is an empty accumulator and may be immediately
+// used.
+type Accumulator struct {
+	sum Money
+	n   int64
+}
+
+// Add adds the value to the accumulator. It returns an error, and leaves the
+// accumulator unchanged, if the value's currency is incompatible with the
+// values added so far, or if the sum would overflow.
+func (a *Accumulator) Add(m Money) error {
+	if err := compat(a.sum.ccy, m.ccy); err != nil {
+		return err
+	}
+	amt, overflow := a.sum.amt.AddOverflow(m.amt)
+	if overflow {
+		return fmt.Errorf("money: sum overflows adding %s to %s", m, a.sum)
+	}
+	a.sum = Money{amt, a.sum.compatCcy(m)}
+	a.n++
+	return nil
+}
+
+// Count returns the number of values added to the accumulator.
+func (a *Accumulator) Count() int64 {
+	return a.n
+}
+
+// Sum returns the sum of the values added to the accumulator. If no values have
+// been added, it returns the currencyless zero.
+func (a *Accumulator) Sum() Money {
+	return a.sum
+}
+
+// Average returns the mean of the values added to the accumulator, rounded to
+// the minor units of their currency using the given rounding mode. It returns
+// an error if no values have been added.
+func (a *Accumulator) Average(mode decimal.RoundingMode) (Money, error) {
+	if a.n == 0 {
+		return Money{}, errors.New("money: average of no values")
+	}
+	if a.sum.ccy == nil {
+		return a.sum, nil
+	}
+	return Money{a.sum.amt.DivInt(a.n, minorExp(a.sum.ccy), mode), a.sum.ccy}, nil
+}
+
+// Sum returns the sum of the given values, or an error if they have different
+// currencies or if the sum overflows. The sum of no values is the currencyless
+// zero.
+func Sum(ms ...Money) (Money, error) {
+	var a Accumulator
+	for _, m := range ms {
+		if err := a.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return a.Sum(), nil
+}
+
+// Average returns the mean of the given values, rounded to the minor units of
+// their currency using the given rounding mode. It returns an error if no values
+// are given, if they have different currencies, or if their sum overflows.
+func Average(mode decimal.RoundingMode, ms ...Money) (Money, error) {
+	var a Accumulator
+	for _, m := range ms {
+		if err := a.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return a.Average(mode)
+}
+
+// Min returns the smallest of the given values. It returns an error if no
+// values are given or if they have different currencies.
+func Min(ms ...Money) (Money, error) {
+	return extreme("min", -1, ms)
+}
+
+// Max returns the largest of the given values. It returns an error if no values
+// are given or if they have different currencies.
+func Max(ms ...Money) (Money, error) {
+	return extreme("max", 1, ms)
+}
+
+func extreme(name string, sign int, ms []Money) (Money, error) {
+	if len(ms) == 0 {
+		return Money{}, fmt.Errorf("money: %s of no values", name)
+	}
+	out := ms[0]
+	for _, m := range ms[1:] {
+		c, err := m.Cmp(out)
+		if err != nil {
+			return Money{}, err
+		}
+		ccy := out.compatCcy(m)
+		if c == sign {
+			out = m
+		}
+		// A currencyless zero takes on the currency of the other values
+		out.ccy = ccy
+	}
+	return out, nil
+}
@@ -0,0 +1,127 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var aggregateTests = []struct {
+	ms                 []Money
+	sum, min, max, avg Money
+}{
+	{[]Money{usd(100)}, usd(100), usd(100), usd(100), usd(100)},
+	{[]Money{usd(100), usd(-50), usd(25)}, usd(75), usd(-50), usd(100), usd(25)},
+	{[]Money{usd(100), usd(100), usd(101)}, usd(301), usd(100), usd(101), usd(100)},
+	{[]Money{usd(100), {}, usd(200)}, usd(300), usd(0), usd(200), usd(100)},
+	{[]Money{{}, usd(-5)}, usd(-5), usd(-5), usd(0), usd(-2)},
+	{[]Money{{}, {}}, Money{}, Money{}, Money{}, Money{}},
+}
+
+func TestAggregates(t *testing.T) {
+	for i, test := range aggregateTests {
+		if r, err := Sum(test.ms...); err != nil || !r.Eq(test.sum) {
+			t.Errorf("[%d] sum expected %s got %s (%v)", i, test.sum, r, err)
+		}
+		if r, err := Min(test.ms...); err != nil || !r.Eq(test.min) {
+			t.Errorf("[%d] min expected %s got %s (%v)", i, test.min, r, err)
+		}
+		if r, err := Max(test.ms...); err != nil || !r.Eq(test.max) {
+			t.Errorf("[%d] max expected %s got %s (%v)", i, test.max, r, err)
+		}
+		if r, err := Average(decimal.RoundHalfEven, test.ms...); err != nil || !r.Eq(test.avg) {
+			t.Errorf("[%d] avg expected %s got %s (%v)", i, test.avg, r, err)
+		}
+	}
+}
+
+func TestAggregateCurrency(t *testing.T) {
+	if r, _ := Min(Money{}, usd(5)); r.Currency() != currency.USD {
+		t.Errorf("expected USD got %s", r)
+	}
+	if r, _ := Max(usd(-5), Money{}); r.Currency() != currency.USD {
+		t.Errorf("expected USD got %s", r)
+	}
+}
+
+func TestAggregateErrors(t *testing.T) {
+	mixed := []Money{usd(100), cad(100)}
+	if _, err := Sum(mixed...); err == nil {
+		t.Error("Sum expected error")
+	}
+	if _, err := Min(mixed...); err == nil {
+		t.Error("Min expected error")
+	}
+	if _, err := Max(mixed...); err == nil {
+		t.Error("Max expected error")
+	}
+	if _, err := Average(decimal.RoundHalfEven, mixed...); err == nil {
+		t.Error("Average expected error")
+	}
+	if _, err := Min(); err == nil {
+		t.Error("Min expected error")
+	}
+	if _, err := Max(); err == nil {
+		t.Error("Max expected error")
+	}
+	if _, err := Average(decimal.RoundHalfEven); err == nil {
+		t.Error("Average expected error")
+	}
+	if r, err := Sum(); err != nil || r != (Money{}) {
+		t.Errorf("expected currencyless zero got %s (%v)", r, err)
+	}
+}
+
+func TestAverageRounding(t *testing.T) {
+	ms := []Money{usd(1), usd(2)}
+	if r, _ := Average(decimal.RoundHalfEven, ms...); !r.Eq(usd(2)) {
+		t.Errorf("expected %s got %s", usd(2), r)
+	}
+	if r, _ := Average(decimal.RoundDown, ms...); !r.Eq(usd(1)) {
+		t.Errorf("expected %s got %s", usd(1), r)
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	var a Accumulator
+	for i := int64(1); i <= 100; i++ {
+		if err := a.Add(usd(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Add(cad(1)); err == nil {
+		t.Error("expected error adding CAD")
+	}
+	if a.Count() != 100 {
+		t.Errorf("expected 100 values got %d", a.Count())
+	}
+	if r := a.Sum(); !r.Eq(usd(5050)) {
+		t.Errorf("expected %s got %s", usd(5050), r)
+	}
+	if r, _ := a.Average(decimal.RoundHalfUp); !r.Eq(usd(51)) {
+		t.Errorf("expected %s got %s", usd(51), r)
+	}
+}
+
+func TestAccumulatorOverflow(t *testing.T) {
+	big := mustparse("10000000000000000000000000000000", "USD")
+	var a Accumulator
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		err = a.Add(big)
+	}
+	if err == nil {
+		t.Fatal("expected overflow")
+	}
+	if r := a.Sum(); r.Sign() <= 0 {
+		t.Errorf("expected sum to be unchanged, got %s", r)
+	}
+	ms := make([]Money, 20)
+	for i := range ms {
+		ms[i] = big.Neg()
+	}
+	if _, err := Sum(ms...); err == nil {
+		t.Error("expected overflow")
+	}
+}
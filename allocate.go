@@ -0,0 +1,46 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zenazn/money/decimal"
+)
+
+// Allocate splits the receiver into parts proportional to the given weights,
+// each a whole number of minor units, which sum exactly to the receiver. For
+// instance, allocating USD 1.00 with weights 1, 1 and 1 returns USD 0.34, USD
+// 0.33 and USD 0.33.
+//
+// Minor units that can't be split evenly go to the parts whose exact shares
+// were rounded down the most, with ties going to the earliest part. Allocate
+// returns an error if no weights are given, if any weight is negative, if the
+// weights sum to zero, or if the receiver is not a whole number of minor units.
+func (m Money) Allocate(weights ...decimal.Decimal) ([]Money, error) {
+	if len(weights) == 0 {
+		return nil, errors.New("money: allocate with no weights")
+	}
+	nonzero := false
+	for _, w := range weights {
+		if w.Sign() < 0 {
+			return nil, fmt.Errorf("money: allocate with negative weight %s", w)
+		}
+		nonzero = nonzero || w.Sign() > 0
+	}
+	if !nonzero {
+		return nil, errors.New("money: allocate with weights that sum to zero")
+	}
+
+	out := make([]Money, len(weights))
+	if m.ccy == nil {
+		return out, nil
+	}
+	exp := minorExp(m.ccy)
+	if !m.amt.Round(exp, decimal.RoundDown).Eq(m.amt) {
+		return nil, fmt.Errorf("money: allocate %s, which is not a whole number of minor units", m)
+	}
+	for i, amt := range m.amt.Allocate(weights, exp) {
+		out[i] = Money{amt, m.ccy}
+	}
+	return out, nil
+}
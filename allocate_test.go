@@ -0,0 +1,66 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/decimal"
+)
+
+func weights(ws ...int64) []decimal.Decimal {
+	ds := make([]decimal.Decimal, len(ws))
+	for i, w := range ws {
+		ds[i] = decimal.FromI64(w)
+	}
+	return ds
+}
+
+var allocateTests = []struct {
+	m       Money
+	weights []decimal.Decimal
+	parts   []string
+}{
+	{usd(100), weights(1, 1, 1), []string{"USD 0.34", "USD 0.33", "USD 0.33"}},
+	{usd(-100), weights(1, 1, 1), []string{"USD -0.34", "USD -0.33", "USD -0.33"}},
+	{usd(5), weights(3, 7), []string{"USD 0.02", "USD 0.03"}},
+	{usd(10), weights(3, 7, 1), []string{"USD 0.03", "USD 0.06", "USD 0.01"}},
+	{usd(1000), weights(0, 1), []string{"USD 0.00", "USD 10.00"}},
+	{btc(7), weights(1, 1), []string{"XBT 0.00000004", "XBT 0.00000003"}},
+	{Money{}, weights(1, 1), []string{"0", "0"}},
+}
+
+func TestAllocate(t *testing.T) {
+	for i, test := range allocateTests {
+		parts, err := test.m.Allocate(test.weights...)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+			continue
+		}
+		if len(parts) != len(test.parts) {
+			t.Errorf("[%d] expected %d parts got %d", i, len(test.parts), len(parts))
+			continue
+		}
+		for j, p := range parts {
+			if s := p.String(); s != test.parts[j] {
+				t.Errorf("[%d] expected part %d %q got %q", i, j, test.parts[j], s)
+			}
+		}
+		if sum, _ := Sum(parts...); !sum.Eq(test.m) {
+			t.Errorf("[%d] parts sum to %v, not %v", i, sum, test.m)
+		}
+	}
+}
+
+func TestAllocateErrors(t *testing.T) {
+	if _, err := usd(100).Allocate(); err == nil {
+		t.Error("expected error for no weights")
+	}
+	if _, err := usd(100).Allocate(weights(1, -1)...); err == nil {
+		t.Error("expected error for negative weight")
+	}
+	if _, err := usd(100).Allocate(weights(0, 0)...); err == nil {
+		t.Error("expected error for zero weights")
+	}
+	if _, err := mustparse("1.005", "USD").Allocate(weights(1, 1)...); err == nil {
+		t.Error("expected error for fractional minor units")
+	}
+}
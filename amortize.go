@@ -0,0 +1,162 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zenazn/money/decimal"
+)
+
+// AmortizationMethod determines how a loan's payments are split between
+// interest and principal.
+type AmortizationMethod int
+
+const (
+	// Annuity loans have level payments, which pay off more principal as
+	// the interest due on the balance falls.
+	Annuity AmortizationMethod = iota
+	// EqualPrincipal loans pay off the same amount of principal in every
+	// period, so their payments fall over time.
+	EqualPrincipal
+	// InterestOnly loans pay only interest until the final period, when
+	// the entire principal is due.
+	InterestOnly
+)
+
+// PaymentFrequency is how often payments are made on a loan.
+type PaymentFrequency int
+
+const (
+	// Monthly loans have 12 periods per year.
+	Monthly PaymentFrequency = iota
+	// Weekly loans have 52 periods per year.
+	Weekly
+)
+
+func (f PaymentFrequency) periodsPerYear() (int64, error) {
+	switch f {
+	case Monthly:
+		return 12, nil
+	case Weekly:
+		return 52, nil
+	}
+	return 0, fmt.Errorf("money: unknown payment frequency %d", int(f))
+}
+
+// Loan describes a fixed-rate loan.
+type Loan struct {
+	Principal Money
+	// AnnualRate is the nominal annual interest rate. The interest rate of
+	// each period is the annual rate divided by the number of periods per
+	// year.
+	AnnualRate decimal.Rate
+	// Periods is the number of payments.
+	Periods   int
+	Frequency PaymentFrequency
+	Method    AmortizationMethod
+}
+
+// Installment is a single row of an amortization schedule.
+type Installment struct {
+	// Period is the number of the payment, starting from 1.
+	Period int
+	// Payment is the sum of Interest and Principal.
+	Payment   Money
+	Interest  Money
+	Principal Money
+	// Balance is the principal outstanding after the payment.
+	Balance Money
+}
+
+// annuityNotional is the notional value compounded to compute the growth of
+// annuities, and growthLimit bounds their growth so compounding can't overflow.
+var (
+	annuityNotional = decimal.FromI64(1000000000000000000)
+	growthLimit     = annuityNotional.MulDiv(annuityNotional, decimal.FromI64(1), 0, decimal.RoundDown)
+)
+
+// Amortize returns the payment schedule of the given loan.
+//
+// Interest is charged on the outstanding balance in each period and rounded to
+// the minor units of the loan's currency using Bankers Rounding, as are
+// payments. Rounding residuals are absorbed by the final payment, so the
+// principal payments always sum to exactly the loan's principal.
+//
+// Amortize returns an error if the principal is not positive or is more precise
+// than the minor units of its currency, if the number of periods is not
+// positive, or if the rate is negative or too large to compound.
+func Amortize(l Loan) ([]Installment, error) {
+	p := l.Principal
+	if p.ccy == nil || p.Sign() <= 0 {
+		return nil, fmt.Errorf("money: loan principal %s is not positive", p)
+	}
+	exp := minorExp(p.ccy)
+	if p.Round(decimal.RoundDown) != p {
+		return nil, fmt.Errorf("money: loan principal %s is more precise than minor units", p)
+	}
+	if l.Periods <= 0 {
+		return nil, errors.New("money: loan has no periods")
+	}
+	k, err := l.Frequency.periodsPerYear()
+	if err != nil {
+		return nil, err
+	}
+	ppm := l.AnnualRate.PPM()
+	if ppm < 0 {
+		return nil, errors.New("money: loan rate is negative")
+	} else if ppm > 100*k*1000000 {
+		return nil, errors.New("money: loan rate is too large")
+	}
+
+	// The interest rate of each period is rate/per
+	rate := decimal.FromI64(ppm)
+	per := decimal.FromI64(k * 1000000)
+
+	var payment, principal decimal.Decimal
+	switch l.Method {
+	case Annuity:
+		if ppm == 0 {
+			payment = p.amt.DivInt(int64(l.Periods), exp, decimal.RoundHalfEven)
+			break
+		}
+		// The payment is p*i*g/(g-1), where i is the periodic rate and
+		// g = (1+i)**n is the growth of the balance over the life of
+		// the loan. Compound a large notional value to compute g with
+		// far more precision than a Rate has.
+		growth := annuityNotional
+		for i := 0; i < l.Periods; i++ {
+			if growth.Gt(growthLimit) {
+				return nil, errors.New("money: loan rate is too large")
+			}
+			growth = growth.MulDiv(per.Add(rate), per, 0, decimal.RoundHalfEven)
+		}
+		payment = p.amt.MulDiv(growth, growth.Sub(annuityNotional), 0, decimal.RoundHalfEven)
+		payment = payment.MulDiv(rate, per, exp, decimal.RoundHalfEven)
+	case EqualPrincipal:
+		principal = p.amt.DivInt(int64(l.Periods), exp, decimal.RoundHalfEven)
+	case InterestOnly:
+	default:
+		return nil, fmt.Errorf("money: unknown amortization method %d", int(l.Method))
+	}
+
+	schedule := make([]Installment, l.Periods)
+	balance := p.amt
+	for i := range schedule {
+		interest := balance.MulDiv(rate, per, exp, decimal.RoundHalfEven)
+		if l.Method == Annuity {
+			principal = payment.Sub(interest)
+		}
+		if i == len(schedule)-1 || principal.Gt(balance) {
+			principal = balance
+		}
+		balance = balance.Sub(principal)
+		schedule[i] = Installment{
+			Period:    i + 1,
+			Payment:   Money{interest.Add(principal), p.ccy},
+			Interest:  Money{interest, p.ccy},
+			Principal: Money{principal, p.ccy},
+			Balance:   Money{balance, p.ccy},
+		}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,118 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/decimal"
+)
+
+var amortizeTests = []struct {
+	loan             Loan
+	first, last, nth Installment
+}{
+	{
+		Loan{usd(10000000), decimal.NewRate(60000), 360, Monthly, Annuity},
+		Installment{1, usd(59955), usd(50000), usd(9955), usd(9990045)},
+		Installment{360, usd(60000), usd(299), usd(59701), usd(0)},
+		Installment{359, usd(59955), usd(595), usd(59360), usd(59701)},
+	},
+	{
+		Loan{usd(10000000), decimal.NewRate(60000), 360, Monthly, EqualPrincipal},
+		Installment{1, usd(77778), usd(50000), usd(27778), usd(9972222)},
+		Installment{360, usd(27836), usd(138), usd(27698), usd(0)},
+		Installment{359, usd(28055), usd(277), usd(27778), usd(27698)},
+	},
+	{
+		Loan{usd(10000000), decimal.NewRate(60000), 360, Monthly, InterestOnly},
+		Installment{1, usd(50000), usd(50000), usd(0), usd(10000000)},
+		Installment{360, usd(10050000), usd(50000), usd(10000000), usd(0)},
+		Installment{359, usd(50000), usd(50000), usd(0), usd(10000000)},
+	},
+	{
+		Loan{usd(100000), decimal.NewRate(0), 3, Weekly, Annuity},
+		Installment{1, usd(33333), usd(0), usd(33333), usd(66667)},
+		Installment{3, usd(33334), usd(0), usd(33334), usd(0)},
+		Installment{2, usd(33333), usd(0), usd(33333), usd(33334)},
+	},
+	{
+		Loan{usd(520000), decimal.NewRate(52000), 2, Weekly, Annuity},
+		Installment{1, usd(260390), usd(520), usd(259870), usd(260130)},
+		Installment{2, usd(260390), usd(260), usd(260130), usd(0)},
+		Installment{1, usd(260390), usd(520), usd(259870), usd(260130)},
+	},
+}
+
+func TestAmortize(t *testing.T) {
+	for i, test := range amortizeTests {
+		s, err := Amortize(test.loan)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+			continue
+		}
+		if len(s) != test.loan.Periods {
+			t.Errorf("[%d] expected %d periods got %d", i, test.loan.Periods, len(s))
+			continue
+		}
+		if s[0] != test.first {
+			t.Errorf("[%d] expected first %v got %v", i, test.first, s[0])
+		}
+		if r := s[len(s)-1]; r != test.last {
+			t.Errorf("[%d] expected last %v got %v", i, test.last, r)
+		}
+		if r := s[test.nth.Period-1]; r != test.nth {
+			t.Errorf("[%d] expected %v got %v", i, test.nth, r)
+		}
+
+		var principal, payments, interest Accumulator
+		for _, r := range s {
+			principal.Add(r.Principal)
+			payments.Add(r.Payment)
+			interest.Add(r.Interest)
+			if !r.Interest.Add(r.Principal).Eq(r.Payment) {
+				t.Errorf("[%d] period %d doesn't add up: %v", i, r.Period, r)
+			}
+		}
+		if !principal.Sum().Eq(test.loan.Principal) {
+			t.Errorf("[%d] expected principal to sum to %s got %s", i, test.loan.Principal, principal.Sum())
+		}
+		if !payments.Sum().Eq(principal.Sum().Add(interest.Sum())) {
+			t.Errorf("[%d] expected payments to sum to %s got %s", i, principal.Sum().Add(interest.Sum()), payments.Sum())
+		}
+	}
+}
+
+var amortizeErrorTests = []Loan{
+	{Money{}, decimal.NewRate(60000), 12, Monthly, Annuity},
+	{usd(-100), decimal.NewRate(60000), 12, Monthly, Annuity},
+	{mustparse("1.001", "USD"), decimal.NewRate(60000), 12, Monthly, Annuity},
+	{usd(100), decimal.NewRate(60000), 0, Monthly, Annuity},
+	{usd(100), decimal.NewRate(-1), 12, Monthly, Annuity},
+	{usd(100), decimal.NewRate(60000), 12, PaymentFrequency(7), Annuity},
+	{usd(100), decimal.NewRate(60000), 12, Monthly, AmortizationMethod(7)},
+	{usd(100), decimal.NewRate(1000000000), 12, Monthly, Annuity},
+	{usd(100), decimal.NewRate(100000000), 30, Monthly, Annuity},
+}
+
+func TestAmortizeErrors(t *testing.T) {
+	for i, loan := range amortizeErrorTests {
+		if _, err := Amortize(loan); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
+
+func TestAmortizeJPY(t *testing.T) {
+	s, err := Amortize(Loan{mustparse("1000000", "JPY"), decimal.NewRate(15000), 12, Monthly, Annuity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// JPY has no minor units, so payments are whole yen
+	for _, r := range s {
+		if r.Payment.Round(decimal.RoundDown) != r.Payment {
+			t.Errorf("period %d payment %s is not whole yen", r.Period, r.Payment)
+		}
+	}
+	if p := s[0].Payment; !p.Eq(mustparse("84012", "JPY")) {
+		t.Errorf("expected JPY 84012 got %s", p)
+	}
+}
@@ -0,0 +1,187 @@
+package money
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zenazn/money/currency"
+)
+
+// Bag is a collection of values of different currencies, for instance the
+// contents of a multi-currency shopping cart or portfolio. Unlike Money, values
+// of different currencies may be added to the same Bag. A Bag holds at most one
+// value per currency, and currencies whose values sum to zero are dropped.
+//
+// The zero value of Bag is an empty bag and may be immediately used. Like
+// Money, Bag values are immutable: every operation returns a new Bag.
+type Bag struct {
+	amts map[string]Money
+}
+
+// NewBag returns a Bag containing the sum of the given values.
+func NewBag(ms ...Money) Bag {
+	b := Bag{make(map[string]Money, len(ms))}
+	for _, m := range ms {
+		b.add(m)
+	}
+	return b
+}
+
+func (b Bag) clone() Bag {
+	c := Bag{make(map[string]Money, len(b.amts)+1)}
+	for k, v := range b.amts {
+		c.amts[k] = v
+	}
+	return c
+}
+
+// add adds the value to the receiver in place. Currencyless zeroes are
+// compatible with every currency, so adding one has no effect.
+func (b Bag) add(m Money) {
+	if m.ccy == nil {
+		return
+	}
+	s := m.ccy.Symbol()
+	// Bag values always have compatible currencies, so Add can't panic
+	sum := b.amts[s].Add(m)
+	if sum.Zero() {
+		delete(b.amts, s)
+	} else {
+		b.amts[s] = sum
+	}
+}
+
+// Add returns a Bag containing the receiver plus the given value.
+func (b Bag) Add(m Money) Bag {
+	c := b.clone()
+	c.add(m)
+	return c
+}
+
+// Sub returns a Bag containing the receiver minus the given value.
+func (b Bag) Sub(m Money) Bag {
+	c := b.clone()
+	c.add(m.Neg())
+	return c
+}
+
+// AddBag returns a Bag containing the sum of the receiver and argument.
+func (b Bag) AddBag(o Bag) Bag {
+	c := b.clone()
+	for _, m := range o.amts {
+		c.add(m)
+	}
+	return c
+}
+
+// SubBag returns a Bag containing the receiver minus the argument.
+func (b Bag) SubBag(o Bag) Bag {
+	c := b.clone()
+	for _, m := range o.amts {
+		c.add(m.Neg())
+	}
+	return c
+}
+
+// Neg returns a Bag containing the negation of every value in the receiver.
+func (b Bag) Neg() Bag {
+	c := Bag{make(map[string]Money, len(b.amts))}
+	for k, v := range b.amts {
+		c.amts[k] = v.Neg()
+	}
+	return c
+}
+
+// IsZero returns true if the bag contains no non-zero values.
+func (b Bag) IsZero() bool {
+	return len(b.amts) == 0
+}
+
+// Equal returns true if the two bags contain equal values in every currency.
+func (b Bag) Equal(o Bag) bool {
+	if len(b.amts) != len(o.amts) {
+		return false
+	}
+	for k, v := range b.amts {
+		ov, ok := o.amts[k]
+		if !ok || !v.amt.Eq(ov.amt) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of currencies with non-zero values in the bag.
+func (b Bag) Len() int {
+	return len(b.amts)
+}
+
+// Get returns the value in the bag of the given non-nil currency, which is zero
+// if the bag contains no value in that currency.
+func (b Bag) Get(ccy currency.Currency) Money {
+	if m, ok := b.amts[ccy.Symbol()]; ok {
+		return m
+	}
+	return Zero(ccy)
+}
+
+// Amounts returns the non-zero values in the bag, ordered by currency symbol.
+func (b Bag) Amounts() []Money {
+	syms := make([]string, 0, len(b.amts))
+	for s := range b.amts {
+		syms = append(syms, s)
+	}
+	sort.Strings(syms)
+
+	ms := make([]Money, len(syms))
+	for i, s := range syms {
+		ms[i] = b.amts[s]
+	}
+	return ms
+}
+
+// RateSource is a source of exchange rates.
+type RateSource interface {
+	// ExchangeRate returns the rate at which the source currency can be
+	// exchanged for the destination currency, or an error if no rate is
+	// available.
+	ExchangeRate(src, dst currency.Currency) (ExchangeRate, error)
+}
+
+// Convert exchanges every value in the bag into the given destination currency
+// using rates from the given source, and returns their sum. Values already in
+// the destination currency are not exchanged. Convert returns an error if the
+// rate source does not have a rate for one of the currencies in the bag.
+func (b Bag) Convert(dst currency.Currency, rs RateSource) (Money, error) {
+	sum := Zero(dst)
+	for _, m := range b.Amounts() {
+		if compat(m.ccy, dst) != nil {
+			e, err := rs.ExchangeRate(m.ccy, dst)
+			if err != nil {
+				return Money{}, err
+			}
+			if m, err = m.ExchangeErr(e); err != nil {
+				return Money{}, err
+			}
+		}
+		var err error
+		if sum, err = sum.AddErr(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return sum, nil
+}
+
+// String renders the values in the bag, ordered by currency symbol, like
+// "EUR 1.30, USD 2.00". An empty bag renders as "0".
+func (b Bag) String() string {
+	if len(b.amts) == 0 {
+		return "0"
+	}
+	ms := b.Amounts()
+	ss := make([]string, len(ms))
+	for i, m := range ms {
+		ss[i] = m.String()
+	}
+	return strings.Join(ss, ", ")
+}
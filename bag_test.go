@@ -0,0 +1,128 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+func cad(cents int64) Money {
+	return FromMinorUnits(cents, currency.CAD)
+}
+
+var bagTests = []struct {
+	add, sub []Money
+	s        string
+}{
+	{nil, nil, "0"},
+	{[]Money{usd(100)}, nil, "USD 1.00"},
+	{[]Money{usd(100), cad(250)}, nil, "CAD 2.50, USD 1.00"},
+	{[]Money{cad(250), usd(100), usd(5)}, nil, "CAD 2.50, USD 1.05"},
+	{[]Money{usd(100), cad(250)}, []Money{usd(100)}, "CAD 2.50"},
+	{[]Money{usd(100), {}}, []Money{{}}, "USD 1.00"},
+	{[]Money{{}}, nil, "0"},
+	{nil, []Money{btc(1), usd(1)}, "USD -0.01, XBT -0.00000001"},
+	{[]Money{usd(1), FromMinorUnits(0, fakeUSD{})}, nil, "USD 0.01"},
+}
+
+func TestBag(t *testing.T) {
+	for i, test := range bagTests {
+		var b Bag
+		for _, m := range test.add {
+			b = b.Add(m)
+		}
+		for _, m := range test.sub {
+			b = b.Sub(m)
+		}
+		if s := b.String(); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+		if nb := NewBag(test.add...).SubBag(NewBag(test.sub...)); !nb.Equal(b) {
+			t.Errorf("[%d] expected %v got %v", i, b, nb)
+		}
+		if z := b.AddBag(b.Neg()); !z.IsZero() {
+			t.Errorf("[%d] expected zero got %v", i, z)
+		}
+		if b.IsZero() != (test.s == "0") {
+			t.Errorf("[%d] expected IsZero %v", i, test.s == "0")
+		}
+	}
+}
+
+func TestBagImmutable(t *testing.T) {
+	a := NewBag(usd(100))
+	b := a.Add(usd(100))
+	a.Sub(usd(100))
+	if a.String() != "USD 1.00" || b.String() != "USD 2.00" {
+		t.Errorf("bags were modified: %v, %v", a, b)
+	}
+}
+
+func TestBagGet(t *testing.T) {
+	b := NewBag(usd(100), cad(250))
+	if m := b.Get(currency.USD); !m.Eq(usd(100)) {
+		t.Errorf("expected %v got %v", usd(100), m)
+	}
+	if m := b.Get(fakeUSD{}); !m.Eq(usd(100)) {
+		t.Errorf("expected %v got %v", usd(100), m)
+	}
+	if m := b.Get(currency.EUR); !m.Zero() || m.Currency() != currency.EUR {
+		t.Errorf("expected EUR zero got %v", m)
+	}
+	if b.Len() != 2 {
+		t.Errorf("expected 2 currencies got %d", b.Len())
+	}
+}
+
+func TestBagEqual(t *testing.T) {
+	a := NewBag(usd(100), cad(250))
+	if !a.Equal(NewBag(cad(250), usd(100))) {
+		t.Errorf("expected %v to equal itself", a)
+	}
+	if a.Equal(NewBag(usd(100))) {
+		t.Errorf("expected %v not to equal USD 1.00", a)
+	}
+	if a.Equal(NewBag(usd(100), cad(251))) {
+		t.Errorf("expected %v not to equal CAD 2.51, USD 1.00", a)
+	}
+	if !(Bag{}).Equal(NewBag()) {
+		t.Errorf("expected empty bags to be equal")
+	}
+}
+
+type rateTable map[string]decimal.Rate
+
+func (r rateTable) ExchangeRate(src, dst currency.Currency) (ExchangeRate, error) {
+	rate, ok := r[src.Symbol()+dst.Symbol()]
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("no rate for %s to %s", src.Symbol(), dst.Symbol())
+	}
+	return NewExchangeRate(src, dst, rate), nil
+}
+
+func TestBagConvert(t *testing.T) {
+	rates := rateTable{"CADUSD": decimal.NewRate(750000)}
+	b := NewBag(usd(100), cad(200))
+
+	m, err := b.Convert(currency.USD, rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Eq(usd(250)) {
+		t.Errorf("expected %v got %v", usd(250), m)
+	}
+
+	if _, err := b.Convert(currency.EUR, rates); err == nil {
+		t.Errorf("expected error converting to EUR")
+	}
+
+	m, err = Bag{}.Convert(currency.EUR, rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Zero() || m.Currency() != currency.EUR {
+		t.Errorf("expected EUR zero got %v", m)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+// binaryVersion is the version of the binary encoding written by AppendBinary.
+const binaryVersion = 1
+
+// AppendBinary appends the binary encoding of the value to b and returns the
+// extended buffer. It implements encoding.BinaryAppender.
+//
+// The encoding is a stable wire format: values encoded by this version of the
+// package can be decoded by all future versions. It begins with a version byte,
+// currently 1. That is followed by the currency's ISO 4217 numeric code as an
+// unsigned varint (as written by encoding/binary's PutUvarint), or 0 for a
+// currencyless zero. Last comes the amount, as an integer number of
+// minimum-representable-units, encoded as a zig-zag varint (as written by
+// Decimal's AppendVarint). Small amounts therefore take few bytes: "USD 12.34"
+// takes seven.
+//
+// Only currencies with ISO 4217 numeric codes can be encoded, and AppendBinary
+// returns an error for any other currency.
+func (m Money) AppendBinary(b []byte) ([]byte, error) {
+	var code int
+	if m.ccy != nil {
+		var ok bool
+		if code, ok = currency.ISONumber(m.ccy); !ok {
+			return b, fmt.Errorf("money: currency %s has no ISO numeric code", m.ccy.Symbol())
+		}
+	}
+	var buf [binary.MaxVarintLen64]byte
+	b = append(b, binaryVersion)
+	b = append(b, buf[:binary.PutUvarint(buf[:], uint64(code))]...)
+	return m.amt.AppendVarint(b), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using the encoding
+// described by AppendBinary. Together with UnmarshalBinary, it also allows Money
+// to be encoded with encoding/gob.
+func (m Money) MarshalBinary() ([]byte, error) {
+	// The version, a two-byte currency code and the longest amount
+	return m.AppendBinary(make([]byte, 0, 22))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes a value
+// encoded by MarshalBinary or AppendBinary, and returns an error if the
+// encoding is malformed, from an unknown version, or for an unknown currency.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("money: empty binary encoding")
+	} else if data[0] != binaryVersion {
+		return fmt.Errorf("money: unknown binary encoding version %d", data[0])
+	}
+	data = data[1:]
+
+	code, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("money: malformed currency in binary encoding")
+	}
+	data = data[n:]
+	amt, n := decimal.ReadVarint(data)
+	if n <= 0 {
+		return fmt.Errorf("money: malformed amount in binary encoding")
+	} else if n != len(data) {
+		return fmt.Errorf("money: %d trailing bytes in binary encoding", len(data)-n)
+	}
+
+	if code == 0 {
+		if amt != (decimal.Decimal{}) {
+			return fmt.Errorf("money: non-zero amount without a currency in binary encoding")
+		}
+		*m = Money{}
+		return nil
+	} else if code > math.MaxUint16 {
+		return fmt.Errorf("money: unknown currency number %d", code)
+	}
+	c, err := currency.FromISONumber(int(code))
+	if err != nil {
+		return fmt.Errorf("money: unknown currency number %d", code)
+	}
+	*m = Money{amt, c}
+	return nil
+}
@@ -0,0 +1,112 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var binaryTests = []struct {
+	m   Money
+	enc string
+}{
+	{Money{}, "010000"},
+	{Zero(currency.EUR), "01d20700"},
+	{mustparse("12.34", "USD"), "01c806c0ace20b"},
+	{mustparse("5", "BYR"), "01ce0780ade204"},
+	{New(decimal.FromI64(-1000000), currency.JPY), "018803ff887a"},
+	{New(decimal.FromI64(-1), currency.USD), "01c80601"},
+	{New(decimal.FromI64(3), currency.XXX), "01e70706"},
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for i, test := range binaryTests {
+		b, err := test.m.MarshalBinary()
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if enc := hex.EncodeToString(b); enc != test.enc {
+			t.Errorf("[%d] expected %q got %q", i, test.enc, enc)
+		}
+
+		buf, _ := hex.DecodeString(test.enc)
+		var m Money
+		if err := m.UnmarshalBinary(buf); err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if m != test.m {
+			t.Errorf("[%d] expected %v got %v", i, test.m, m)
+		}
+	}
+
+	if b, err := btc(1).MarshalBinary(); err == nil {
+		t.Errorf("expected error marshaling XBT got %x", b)
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	b, err := usd(1234).AppendBinary([]byte("prefix"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	} else if enc := hex.EncodeToString(b); enc != "707265666978"+"01c806c0ace20b" {
+		t.Errorf("unexpected encoding %q", enc)
+	}
+
+	buf := make([]byte, 0, 32)
+	if n := testing.AllocsPerRun(100, func() { usd(1234).AppendBinary(buf) }); n != 0 {
+		t.Errorf("expected 0 allocations got %v", n)
+	}
+}
+
+var unmarshalBinaryFailureTests = []string{
+	"",
+	"02c806c0ace20b",
+	"01",
+	"01c8",
+	"01c806",
+	"01c806c0ace2",
+	"01c806c0ace20b00",
+	"010001",
+	"010100",
+	"01e8070100",
+	"01808080808080808080010000",
+	"01c806ffffffffffffffffffffffffffffffffffff04",
+}
+
+func TestUnmarshalBinaryFailures(t *testing.T) {
+	for i, s := range unmarshalBinaryFailureTests {
+		buf, _ := hex.DecodeString(s)
+		var m Money
+		if err := m.UnmarshalBinary(buf); err == nil {
+			t.Errorf("[%d] unexpectedly passed: %v", i, m)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type invoice struct {
+		Number string
+		Total  Money
+		Lines  []Money
+	}
+	in := invoice{"1", usd(1500), []Money{usd(1000), usd(500), {}, mustparse("3", "JPY")}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var out invoice
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if out.Number != in.Number || out.Total != in.Total || len(out.Lines) != len(in.Lines) {
+		t.Fatalf("expected %v got %v", in, out)
+	}
+	for i := range in.Lines {
+		if out.Lines[i] != in.Lines[i] {
+			t.Errorf("[%d] expected %v got %v", i, in.Lines[i], out.Lines[i])
+		}
+	}
+}
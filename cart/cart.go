@@ -0,0 +1,379 @@
+// Package cart computes the totals of shopping carts and invoices: the
+// extended price of each line, discounts, shipping and taxes.
+//
+// Every amount returned by this package is a whole number of minor units, and
+// every total is exactly the sum of its parts. In particular, discounts are
+// allocated across the lines they apply to, so the amount charged for each
+// line, and therefore the amount to refund if it's returned, is known exactly.
+// Amounts that aren't yet rounded to minor units are computed at the full
+// internal precision of Money, so a discount of a third, for instance, is
+// itself rounded slightly.
+package cart
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zenazn/money"
+	"github.com/zenazn/money/decimal"
+	"github.com/zenazn/money/tax"
+)
+
+// Line is a line item of a cart.
+type Line struct {
+	Description string
+	Quantity    int64
+	UnitPrice   money.Money
+}
+
+// Discount is a promotion that takes either a percentage or a fixed amount off
+// the price of some of the lines of a cart. Discounts stack: each discount
+// applies to the prices left after the discounts before it.
+type Discount struct {
+	Name string
+	// Rate is the fraction of each eligible line's price that a percentage
+	// discount takes off, between 0% and 100%.
+	Rate decimal.Rate
+	// Amount is the amount that a fixed discount takes off the eligible
+	// lines, which is allocated across them in proportion to their prices.
+	// If Amount is non-zero, Rate must be zero. A fixed discount never
+	// takes off more than the price of the eligible lines.
+	Amount money.Money
+	// Lines are the indices of the lines the discount applies to. If Lines
+	// is nil, the discount applies to every line.
+	Lines []int
+}
+
+// Rounding determines when the amounts in a cart are rounded to minor units.
+type Rounding int
+
+const (
+	// RoundEachLine rounds the extended price of each line, and each
+	// discount on it, as soon as they are computed.
+	RoundEachLine Rounding = iota
+	// RoundAfterDiscounts computes the price of each line at the full
+	// internal precision of Money, and rounds it to minor units once all
+	// discounts have been taken off.
+	RoundAfterDiscounts
+	// RoundAtEnd computes every price at the full internal precision of
+	// Money, and only rounds the cart's subtotal and discounts to minor
+	// units, which are then allocated across its lines.
+	RoundAtEnd
+)
+
+// Cart is a shopping cart or invoice.
+type Cart struct {
+	Lines     []Line
+	Discounts []Discount
+	Shipping  money.Money
+	// Tax computes the taxes on the price of each line, after discounts.
+	// Its zero value levies no taxes.
+	Tax tax.Calculator
+	// TaxShipping is true if shipping is taxed like a line. Shipping is
+	// never discounted.
+	TaxShipping bool
+	Rounding    Rounding
+	// Mode is the rounding mode used to round prices to minor units.
+	Mode decimal.RoundingMode
+}
+
+// LineTotal is the breakdown of the price of a line.
+type LineTotal struct {
+	// Extended is the line's quantity times its unit price.
+	Extended money.Money
+	// Discounts contains the amount each of the cart's discounts takes off
+	// the line, in order.
+	Discounts []money.Money
+	// Price is the extended price less discounts, which taxes are levied
+	// on. If the cart's prices include taxes, it is the gross amount of
+	// the line's tax breakdown, and otherwise it is the net amount.
+	Price money.Money
+	Tax   tax.Breakdown
+}
+
+// Totals is the breakdown of the price of a cart. The gross amount of Total is
+// the amount the customer pays.
+type Totals struct {
+	Lines []LineTotal
+	// Subtotal is the sum of the extended prices of the lines.
+	Subtotal money.Money
+	// Discounts contains the total amount taken off by each discount.
+	Discounts []money.Money
+	Shipping  tax.Breakdown
+	// Total is the sum of the tax breakdowns of the lines and shipping.
+	Total tax.Breakdown
+}
+
+// validate checks that the cart's values share a currency and are in range, and
+// returns zero in that currency.
+func (c Cart) validate() (money.Money, error) {
+	ms := []money.Money{c.Shipping}
+	for i, l := range c.Lines {
+		if l.Quantity < 0 {
+			return money.Money{}, fmt.Errorf("cart: line %d has a negative quantity", i)
+		} else if l.UnitPrice.Sign() < 0 {
+			return money.Money{}, fmt.Errorf("cart: line %d has a negative unit price", i)
+		}
+		ms = append(ms, l.UnitPrice)
+	}
+	for _, d := range c.Discounts {
+		if ppm := d.Rate.PPM(); ppm < 0 || ppm > 1000000 {
+			return money.Money{}, fmt.Errorf("cart: discount %q rate is out of range", d.Name)
+		} else if d.Amount.Sign() < 0 {
+			return money.Money{}, fmt.Errorf("cart: discount %q amount is negative", d.Name)
+		} else if d.Amount.Sign() > 0 && d.Rate.PPM() != 0 {
+			return money.Money{}, fmt.Errorf("cart: discount %q has both a rate and an amount", d.Name)
+		}
+		seen := make(map[int]bool, len(d.Lines))
+		for _, i := range d.Lines {
+			if i < 0 || i >= len(c.Lines) {
+				return money.Money{}, fmt.Errorf("cart: discount %q applies to unknown line %d", d.Name, i)
+			} else if seen[i] {
+				return money.Money{}, fmt.Errorf("cart: discount %q applies to line %d twice", d.Name, i)
+			}
+			seen[i] = true
+		}
+		ms = append(ms, d.Amount)
+	}
+	if c.Shipping.Sign() < 0 {
+		return money.Money{}, errors.New("cart: negative shipping")
+	}
+	if c.Rounding < RoundEachLine || c.Rounding > RoundAtEnd {
+		return money.Money{}, fmt.Errorf("cart: unknown rounding %d", int(c.Rounding))
+	}
+	var zero money.Money
+	for _, m := range ms {
+		if !m.ComparableTo(zero) {
+			return money.Money{}, fmt.Errorf("cart: incompatible currencies %s and %s", zero.Currency().Symbol(), m.Currency().Symbol())
+		}
+		if m.Currency() != nil {
+			zero = money.Zero(m.Currency())
+		}
+	}
+	return zero, nil
+}
+
+// mulDiv returns m*num/den, rounded to minor units if round is true, and
+// otherwise to the internal precision of Money.
+func (c Cart) mulDiv(m money.Money, num, den int64, round bool) money.Money {
+	n, d := decimal.FromI64(num), decimal.FromI64(den)
+	if round {
+		return m.MulDivRound(n, d, c.Mode)
+	} else if m.Currency() == nil {
+		return m
+	}
+	return money.New(m.Amount().MulDiv(n, d, 0, c.Mode), m.Currency())
+}
+
+// allocate splits m in proportion to the given non-negative weights, which may
+// all be zero if m is. If exact is true, the parts need not be whole minor
+// units.
+func allocate(m money.Money, ws []money.Money, exact bool) ([]money.Money, error) {
+	out := make([]money.Money, len(ws))
+	if m.Sign() == 0 {
+		for i := range out {
+			out[i] = m
+		}
+		return out, nil
+	}
+	weights := make([]decimal.Decimal, len(ws))
+	for i, w := range ws {
+		weights[i] = w.Amount()
+	}
+	if !exact {
+		return m.Allocate(weights...)
+	}
+	for i, amt := range m.Amount().Allocate(weights, 0) {
+		out[i] = money.New(amt, m.Currency())
+	}
+	return out, nil
+}
+
+func sum(ms []money.Money) money.Money {
+	// The cart has been validated, so every value has the same currency
+	var s money.Money
+	for _, m := range ms {
+		s = s.Add(m)
+	}
+	return s
+}
+
+// discount takes the discounts off the given line prices in place, and returns
+// the amount each discount takes off each line.
+func (c Cart) discount(zero money.Money, prices []money.Money, round bool) ([][]money.Money, error) {
+	discs := make([][]money.Money, len(c.Discounts))
+	for k, d := range c.Discounts {
+		lines := d.Lines
+		if lines == nil {
+			lines = make([]int, len(prices))
+			for i := range lines {
+				lines[i] = i
+			}
+		}
+		eligible := make([]money.Money, len(lines))
+		for j, i := range lines {
+			eligible[j] = prices[i]
+		}
+
+		var parts []money.Money
+		if d.Amount.Sign() > 0 {
+			amt, avail := d.Amount, sum(eligible)
+			if round {
+				amt = amt.Round(c.Mode)
+			}
+			if amt.Gt(avail) {
+				amt = avail
+			}
+			var err error
+			if parts, err = allocate(amt, eligible, !round); err != nil {
+				return nil, err
+			}
+		} else {
+			parts = make([]money.Money, len(lines))
+			for j, p := range eligible {
+				parts[j] = c.mulDiv(p, d.Rate.PPM(), 1000000, round)
+			}
+		}
+
+		discs[k] = make([]money.Money, len(prices))
+		for i := range discs[k] {
+			discs[k][i] = zero
+		}
+		for j, i := range lines {
+			discs[k][i] = discs[k][i].Add(parts[j])
+			prices[i] = prices[i].Sub(parts[j])
+		}
+	}
+	return discs, nil
+}
+
+// Totals returns the breakdown of the price of the cart. It returns an error if
+// the cart's values have different currencies, or if any of them are out of
+// range. Totals panics if an amount overflows.
+func (c Cart) Totals() (Totals, error) {
+	zero, err := c.validate()
+	if err != nil {
+		return Totals{}, err
+	}
+	round := c.Rounding == RoundEachLine
+	exts := make([]money.Money, len(c.Lines))
+	prices := make([]money.Money, len(c.Lines))
+	for i, l := range c.Lines {
+		exts[i] = c.mulDiv(l.UnitPrice, l.Quantity, 1, round)
+		prices[i] = exts[i]
+	}
+	discs, err := c.discount(zero, prices, round)
+	if err != nil {
+		return Totals{}, err
+	}
+
+	lines := make([]LineTotal, len(c.Lines))
+	for i := range lines {
+		lines[i].Discounts = make([]money.Money, len(discs))
+	}
+	switch c.Rounding {
+	case RoundEachLine:
+		for i := range lines {
+			lines[i].Extended, lines[i].Price = exts[i], prices[i]
+			for k := range discs {
+				lines[i].Discounts[k] = discs[k][i]
+			}
+		}
+	case RoundAfterDiscounts:
+		// Split the rounded discount on each line in proportion to the
+		// unrounded discounts on it
+		for i := range lines {
+			lines[i].Extended = exts[i].Round(c.Mode)
+			lines[i].Price = prices[i].Round(c.Mode)
+			unrounded := make([]money.Money, len(discs))
+			for k := range discs {
+				unrounded[k] = discs[k][i]
+			}
+			ds, err := allocate(lines[i].Extended.Sub(lines[i].Price), unrounded, false)
+			if err != nil {
+				return Totals{}, err
+			}
+			copy(lines[i].Discounts, ds)
+		}
+	case RoundAtEnd:
+		// Round the subtotal and the price after discounts, split their
+		// difference between the discounts, and then split the subtotal
+		// and each discount between the lines
+		subtotal := sum(exts).Round(c.Mode)
+		unrounded := make([]money.Money, len(discs))
+		for k := range discs {
+			unrounded[k] = sum(discs[k])
+		}
+		totals, err := allocate(subtotal.Sub(sum(prices).Round(c.Mode)), unrounded, false)
+		if err != nil {
+			return Totals{}, err
+		}
+		ext, err := allocate(subtotal, exts, false)
+		if err != nil {
+			return Totals{}, err
+		}
+		for i := range lines {
+			lines[i].Extended, lines[i].Price = ext[i], ext[i]
+		}
+		for k := range discs {
+			ds, err := allocate(totals[k], discs[k], false)
+			if err != nil {
+				return Totals{}, err
+			}
+			for i := range lines {
+				lines[i].Discounts[k] = ds[i]
+				lines[i].Price = lines[i].Price.Sub(ds[i])
+			}
+		}
+	}
+
+	return c.totals(zero, lines)
+}
+
+// totals computes the taxes on the given lines and shipping, and sums them.
+func (c Cart) totals(zero money.Money, lines []LineTotal) (Totals, error) {
+	shipping := zero.Add(c.Shipping).Round(c.Mode)
+	taxed := make([]money.Money, len(lines), len(lines)+1)
+	for i, l := range lines {
+		taxed[i] = l.Price
+	}
+	if c.TaxShipping {
+		taxed = append(taxed, shipping)
+	}
+	inv, err := c.Tax.Invoice(taxed)
+	if err != nil {
+		return Totals{}, err
+	}
+
+	t := Totals{
+		Lines:     lines,
+		Subtotal:  zero,
+		Discounts: make([]money.Money, len(c.Discounts)),
+		Total:     inv.Total,
+	}
+	for k := range t.Discounts {
+		t.Discounts[k] = zero
+	}
+	for i := range lines {
+		lines[i].Tax = inv.Lines[i]
+		t.Subtotal = t.Subtotal.Add(lines[i].Extended)
+		for k, d := range lines[i].Discounts {
+			t.Discounts[k] = t.Discounts[k].Add(d)
+		}
+	}
+	if c.TaxShipping {
+		t.Shipping = inv.Lines[len(lines)]
+	} else {
+		t.Shipping = tax.Breakdown{
+			Net:   shipping,
+			Taxes: make([]money.Money, len(c.Tax.Taxes)),
+			Gross: shipping,
+		}
+		for i := range t.Shipping.Taxes {
+			t.Shipping.Taxes[i] = zero
+		}
+		t.Total.Net = t.Total.Net.Add(shipping)
+		t.Total.Gross = t.Total.Gross.Add(shipping)
+	}
+	return t, nil
+}
@@ -0,0 +1,221 @@
+package cart
+
+import (
+	"testing"
+
+	"github.com/zenazn/money"
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+	"github.com/zenazn/money/tax"
+)
+
+func usd(cents int64) money.Money {
+	return money.FromMinorUnits(cents, currency.USD)
+}
+
+func mustparse(s string) money.Money {
+	m, err := money.Parse(s, "USD")
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func testCart(r Rounding) Cart {
+	return Cart{
+		Lines: []Line{
+			{"Widget", 2, usd(999)},
+			{"Gadget", 1, usd(500)},
+			{"Screw", 3, mustparse("0.333")},
+		},
+		Discounts: []Discount{
+			{Name: "Sale", Rate: decimal.NewRate(100000)},
+			{Name: "Coupon", Amount: usd(500), Lines: []int{0, 1}},
+		},
+		Shipping: usd(499),
+		Tax:      tax.Calculator{Taxes: []tax.Tax{{Name: "Sales tax", Rate: decimal.NewRate(80000)}}},
+		Rounding: r,
+	}
+}
+
+func TestTotals(t *testing.T) {
+	for _, r := range []Rounding{RoundEachLine, RoundAfterDiscounts, RoundAtEnd} {
+		totals, err := testCart(r).Totals()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []struct {
+			extended, sale, coupon, price, tax string
+		}{
+			{"USD 19.98", "USD 2.00", "USD 4.00", "USD 13.98", "USD 1.12"},
+			{"USD 5.00", "USD 0.50", "USD 1.00", "USD 3.50", "USD 0.28"},
+			{"USD 1.00", "USD 0.10", "USD 0.00", "USD 0.90", "USD 0.07"},
+		}
+		for i, e := range expected {
+			l := totals.Lines[i]
+			got := []string{l.Extended.String(), l.Discounts[0].String(), l.Discounts[1].String(), l.Price.String(), l.Tax.Tax().String()}
+			for j, s := range []string{e.extended, e.sale, e.coupon, e.price, e.tax} {
+				if got[j] != s {
+					t.Errorf("%d: line %d expected %q got %q", r, i, s, got[j])
+				}
+			}
+		}
+		if s := totals.Total.Gross.String(); s != "USD 24.84" {
+			t.Errorf("%d: expected total %q got %q", r, "USD 24.84", s)
+		}
+		if s := totals.Shipping.Tax().String(); s != "USD 0.00" {
+			t.Errorf("%d: expected no shipping tax got %q", r, s)
+		}
+	}
+}
+
+var roundingTests = []struct {
+	r                   Rounding
+	subtotal, discounts string
+}{
+	{RoundEachLine, "USD 0.15", "USD 0.00"},
+	{RoundAfterDiscounts, "USD 0.15", "USD 0.03"},
+	{RoundAtEnd, "USD 0.15", "USD 0.01"},
+}
+
+func TestRounding(t *testing.T) {
+	for i, test := range roundingTests {
+		c := Cart{
+			Lines:     []Line{{"A", 1, usd(5)}, {"B", 1, usd(5)}, {"C", 1, usd(5)}},
+			Discounts: []Discount{{Name: "Sale", Rate: decimal.NewRate(100000)}},
+			Rounding:  test.r,
+		}
+		totals, err := c.Totals()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := totals.Subtotal.String(); s != test.subtotal {
+			t.Errorf("[%d] expected subtotal %q got %q", i, test.subtotal, s)
+		}
+		if s := totals.Discounts[0].String(); s != test.discounts {
+			t.Errorf("[%d] expected discounts %q got %q", i, test.discounts, s)
+		}
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	for _, r := range []Rounding{RoundEachLine, RoundAfterDiscounts, RoundAtEnd} {
+		for _, inclusive := range []bool{false, true} {
+			c := testCart(r)
+			c.Tax.Inclusive = inclusive
+			c.TaxShipping = true
+			c.Lines = append(c.Lines, Line{"Nail", 7, mustparse("0.0125")})
+			c.Discounts = append(c.Discounts, Discount{Name: "Loyalty", Rate: decimal.NewRate(33333)})
+			totals, err := c.Totals()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var subtotal, gross money.Money
+			discounts := make([]money.Money, len(c.Discounts))
+			for i, l := range totals.Lines {
+				price := l.Extended
+				for k, d := range l.Discounts {
+					price = price.Sub(d)
+					discounts[k] = discounts[k].Add(d)
+				}
+				if !price.Eq(l.Price) {
+					t.Errorf("%d/%v: line %d discounts don't reconcile: %v", r, inclusive, i, l)
+				}
+				if !l.Tax.Net.Add(l.Tax.Tax()).Eq(l.Tax.Gross) {
+					t.Errorf("%d/%v: line %d taxes don't reconcile: %v", r, inclusive, i, l.Tax)
+				}
+				if !l.Price.Round(decimal.RoundDown).Eq(l.Price) {
+					t.Errorf("%d/%v: line %d price %v is not whole cents", r, inclusive, i, l.Price)
+				}
+				subtotal = subtotal.Add(l.Extended)
+				gross = gross.Add(l.Tax.Gross)
+			}
+			gross = gross.Add(totals.Shipping.Gross)
+			if !subtotal.Eq(totals.Subtotal) {
+				t.Errorf("%d/%v: lines sum to %v, not %v", r, inclusive, subtotal, totals.Subtotal)
+			}
+			for k := range discounts {
+				if !discounts[k].Eq(totals.Discounts[k]) {
+					t.Errorf("%d/%v: discount %d sums to %v, not %v", r, inclusive, k, discounts[k], totals.Discounts[k])
+				}
+			}
+			if !gross.Eq(totals.Total.Gross) {
+				t.Errorf("%d/%v: gross sums to %v, not %v", r, inclusive, gross, totals.Total.Gross)
+			}
+		}
+	}
+}
+
+// Discounts are computed at the internal precision of Money, so a discount of
+// a third leaves a residue that rounding at the end must hand out to the lines
+// so that they still add up to the total.
+func TestRoundAtEndReconciles(t *testing.T) {
+	c := Cart{
+		Lines: []Line{
+			{"Widget", 1, mustparse("10.000001")},
+			{"Gadget", 1, mustparse("20.000002")},
+			{"Screw", 3, mustparse("0.000001")},
+		},
+		Discounts: []Discount{{Name: "A third off", Rate: decimal.NewRate(333333)}},
+		Rounding:  RoundAtEnd,
+	}
+	totals, err := c.Totals()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prices := []string{"USD 6.67", "USD 13.33", "USD 0.00"}
+	var sum money.Money
+	for i, l := range totals.Lines {
+		if s := l.Price.String(); s != prices[i] {
+			t.Errorf("[%d] expected %q got %q", i, prices[i], s)
+		}
+		sum = sum.Add(l.Price)
+	}
+	if s := totals.Total.Gross.String(); s != "USD 20.00" {
+		t.Errorf("expected total %q got %q", "USD 20.00", s)
+	}
+	if !sum.Eq(totals.Total.Gross) {
+		t.Errorf("lines sum to %v, not %v", sum, totals.Total.Gross)
+	}
+	if d := totals.Subtotal.Sub(totals.Discounts[0]); !d.Eq(totals.Total.Gross) {
+		t.Errorf("subtotal less discounts is %v, not %v", d, totals.Total.Gross)
+	}
+}
+
+func TestFixedDiscountCapped(t *testing.T) {
+	c := Cart{
+		Lines:     []Line{{"A", 1, usd(300)}, {"B", 1, usd(700)}},
+		Discounts: []Discount{{Name: "Gift card", Amount: usd(5000), Lines: []int{1}}},
+	}
+	totals, err := c.Totals()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := totals.Discounts[0].String(); s != "USD 7.00" {
+		t.Errorf("expected discount %q got %q", "USD 7.00", s)
+	}
+	if s := totals.Total.Gross.String(); s != "USD 3.00" {
+		t.Errorf("expected total %q got %q", "USD 3.00", s)
+	}
+}
+
+func TestTotalsErrors(t *testing.T) {
+	eur := money.FromMinorUnits(100, currency.EUR)
+	for i, c := range []Cart{
+		{Lines: []Line{{"A", -1, usd(100)}}},
+		{Lines: []Line{{"A", 1, usd(-100)}}},
+		{Lines: []Line{{"A", 1, usd(100)}, {"B", 1, eur}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Shipping: eur},
+		{Lines: []Line{{"A", 1, usd(100)}}, Discounts: []Discount{{Rate: decimal.NewRate(1000001)}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Discounts: []Discount{{Rate: decimal.NewRate(1), Amount: usd(1)}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Discounts: []Discount{{Amount: usd(-1)}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Discounts: []Discount{{Amount: usd(1), Lines: []int{1}}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Discounts: []Discount{{Amount: usd(1), Lines: []int{0, 0}}}},
+		{Lines: []Line{{"A", 1, usd(100)}}, Rounding: Rounding(3)},
+	} {
+		if _, err := c.Totals(); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
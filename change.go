@@ -0,0 +1,228 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/zenazn/money/cldr"
+	"github.com/zenazn/money/decimal"
+)
+
+// Denominations contains the banknotes and coins in general circulation for
+// major currencies, keyed by ISO 4217 code. Denominations are given in minor
+// units of their currency (for instance, 2000 is a twenty dollar bill), and are
+// ordered from largest to smallest.
+var Denominations = map[string][]int64{
+	"AUD": {10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5},
+	"BRL": {20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 25, 10, 5},
+	"CAD": {10000, 5000, 2000, 1000, 500, 200, 100, 25, 10, 5},
+	"CHF": {100000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5},
+	"CNY": {10000, 5000, 2000, 1000, 500, 100, 50, 10},
+	"CZK": {500000, 200000, 100000, 50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100},
+	"DKK": {100000, 50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50},
+	"EUR": {50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1},
+	"GBP": {5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1},
+	"HKD": {100000, 50000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10},
+	"INR": {50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100},
+	"JPY": {10000, 5000, 2000, 1000, 500, 100, 50, 10, 5, 1},
+	"KRW": {50000, 10000, 5000, 1000, 500, 100, 50, 10},
+	"MXN": {100000, 50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50},
+	"NOK": {100000, 50000, 20000, 10000, 2000, 1000, 500, 100},
+	"NZD": {10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10},
+	"PLN": {50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1},
+	"SEK": {100000, 50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100},
+	"SGD": {100000, 10000, 5000, 1000, 500, 200, 100, 50, 20, 10, 5},
+	"USD": {10000, 5000, 2000, 1000, 500, 200, 100, 50, 25, 10, 5, 1},
+	"ZAR": {20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10},
+}
+
+// cashIncrement returns the smallest amount of the currency that can be paid in
+// cash, in units of the currency's internal representation, as given by CLDR.
+func (m Money) cashIncrement() (decimal.Decimal, error) {
+	u := m.ccy.Units()
+	digits, rounding := int(u.MinorUnitsInMajorUnitExponent), 1
+	if f, ok := cldr.CurrencyFractions[m.ccy.Symbol()]; ok {
+		digits = f.CashDigits
+		if f.CashRounding != 0 {
+			rounding = f.CashRounding
+		}
+	}
+	exp := int(u.MajorUnitScalingFactorExponent) - digits
+	if exp < 0 {
+		return decimal.Decimal{}, fmt.Errorf("money: %s cash is more precise than its representation", m.ccy.Symbol())
+	}
+	inc := decimal.FromI64(int64(rounding))
+	for ; exp > 0; exp-- {
+		inc = inc.Mul(ten)
+	}
+	return inc, nil
+}
+
+// CashRound rounds the receiver to the smallest amount of its currency that can
+// be paid in cash, according to CLDR, using the given rounding mode. For
+// instance, Swiss francs are rounded to multiples of 5 centimes, and Danish
+// kroner to multiples of 50 øre. It panics if the currency's cash amounts can't
+// be represented.
+func (m Money) CashRound(mode decimal.RoundingMode) Money {
+	if m.ccy == nil {
+		return m
+	}
+	inc, err := m.cashIncrement()
+	if err != nil {
+		panic(err)
+	}
+	one := decimal.FromI64(1)
+	n := m.amt.MulDiv(one, inc, 0, mode)
+	return Money{n.MulDiv(inc, one, 0, decimal.RoundDown), m.ccy}
+}
+
+// MakeChange breaks the amount into the banknotes and coins of its currency
+// listed in Denominations. It returns the number of each denomination to use,
+// keyed by the denomination in minor units, omitting denominations that aren't
+// used. Larger denominations are preferred.
+//
+// If stock is non-nil, it limits the number of each denomination available;
+// denominations missing from it aren't available. If stock is nil, every
+// denomination is available in unlimited numbers.
+//
+// MakeChange returns an error if the amount is negative, if it can't be paid in
+// cash (use CashRound to round it first), if there are no known denominations
+// for its currency, if stock contains an unknown denomination, or if the amount
+// can't be made exactly with the available stock.
+func MakeChange(amount Money, stock map[int64]int64) (map[int64]int64, error) {
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("money: can't make change for negative amount %s", amount)
+	} else if amount.ccy == nil {
+		return map[int64]int64{}, nil
+	}
+	sym := amount.ccy.Symbol()
+	denoms, ok := Denominations[sym]
+	if !ok {
+		return nil, fmt.Errorf("money: no known denominations of %s", sym)
+	}
+	if _, err := amount.cashIncrement(); err != nil {
+		return nil, err
+	} else if !amount.CashRound(decimal.RoundDown).Eq(amount) {
+		return nil, fmt.Errorf("money: %s can't be paid in cash", amount)
+	}
+
+	// Both amount and the denominations are in minor units from here on
+	minor := decimal.FromI64(1)
+	for i := minorExp(amount.ccy); i > 0; i-- {
+		minor = minor.Mul(ten)
+	}
+	total, ok := amount.amt.MulDiv(decimal.FromI64(1), minor, 0, decimal.RoundDown).Int64()
+	if !ok {
+		return nil, fmt.Errorf("money: too much %s to make change for", sym)
+	}
+
+	denoms = append([]int64(nil), denoms...)
+	sort.Slice(denoms, func(i, j int) bool { return denoms[i] > denoms[j] })
+	avail := make([]int64, len(denoms))
+	for i, d := range denoms {
+		avail[i] = -1
+		if stock != nil {
+			avail[i] = stock[d]
+		}
+	}
+	for d, n := range stock {
+		if n < 0 {
+			return nil, fmt.Errorf("money: negative stock of %s denomination %d", sym, d)
+		}
+		known := false
+		for _, k := range denoms {
+			known = known || k == d
+		}
+		if !known {
+			return nil, fmt.Errorf("money: unknown %s denomination %d", sym, d)
+		}
+	}
+
+	c := changeMaker{denoms: denoms, avail: avail, counts: make([]int64, len(denoms))}
+	c.init()
+	if !c.make(0, total) {
+		return nil, errors.New("money: can't make exact change for " + amount.String())
+	}
+	out := make(map[int64]int64)
+	for i, n := range c.counts {
+		if n > 0 {
+			out[denoms[i]] = n
+		}
+	}
+	return out, nil
+}
+
+// changeMaker searches for a combination of denominations, ordered from largest
+// to smallest, that sums to an amount. An availability of -1 is unlimited.
+type changeMaker struct {
+	denoms, avail, counts []int64
+	// gcds contains the greatest common divisor of the available
+	// denominations in each suffix of denoms, and capacity the total value available in each suffix, or -1 if it
+	// is unlimited.
+	gcds, capacity []int64
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func (c *changeMaker) init() {
+	n := len(c.denoms)
+	c.gcds = make([]int64, n+1)
+	c.capacity = make([]int64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		c.gcds[i] = c.gcds[i+1]
+		if c.avail[i] != 0 {
+			c.gcds[i] = gcd(c.denoms[i], c.gcds[i+1])
+		}
+		c.capacity[i] = -1
+		if c.avail[i] >= 0 && c.capacity[i+1] >= 0 {
+			// Saturate rather than overflow; anything this large is
+			// effectively unlimited
+			v := c.avail[i]
+			if v > (1<<62)/c.denoms[i] {
+				continue
+			}
+			if v*c.denoms[i] <= (1<<62)-c.capacity[i+1] {
+				c.capacity[i] = v*c.denoms[i] + c.capacity[i+1]
+			}
+		}
+	}
+}
+
+// make finds counts of the denominations from i onwards that sum to amount,
+// trying larger numbers of larger denominations first.
+func (c *changeMaker) make(i int, amount int64) bool {
+	if amount == 0 {
+		for ; i < len(c.counts); i++ {
+			c.counts[i] = 0
+		}
+		return true
+	} else if c.gcds[i] == 0 || amount%c.gcds[i] != 0 {
+		return false
+	} else if c.capacity[i] >= 0 && amount > c.capacity[i] {
+		return false
+	}
+
+	d := c.denoms[i]
+	n := amount / d
+	if c.avail[i] >= 0 && n > c.avail[i] {
+		n = c.avail[i]
+	}
+	for ; n >= 0; n-- {
+		rest := amount - n*d
+		if c.capacity[i+1] >= 0 && rest > c.capacity[i+1] {
+			// Using fewer of this denomination only leaves more
+			return false
+		}
+		c.counts[i] = n
+		if c.make(i+1, rest) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var cashRoundTests = []struct {
+	m    Money
+	mode decimal.RoundingMode
+	s    string
+}{
+	{FromMinorUnits(327, currency.CHF), decimal.RoundHalfEven, "CHF 3.25"},
+	{FromMinorUnits(328, currency.CHF), decimal.RoundHalfEven, "CHF 3.30"},
+	{FromMinorUnits(328, currency.CHF), decimal.RoundDown, "CHF 3.25"},
+	{FromMinorUnits(-328, currency.CHF), decimal.RoundHalfEven, "CHF -3.30"},
+	{FromMinorUnits(1275, currency.DKK), decimal.RoundHalfEven, "DKK 13.00"},
+	{FromMinorUnits(1274, currency.DKK), decimal.RoundHalfEven, "DKK 12.50"},
+	{FromMinorUnits(12345, currency.HUF), decimal.RoundHalfEven, "HUF 123.00"},
+	{usd(327), decimal.RoundHalfEven, "USD 3.27"},
+	{mustparse("3.275", "USD"), decimal.RoundHalfEven, "USD 3.28"},
+}
+
+func TestCashRound(t *testing.T) {
+	for i, test := range cashRoundTests {
+		if s := test.m.CashRound(test.mode).String(); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+}
+
+var makeChangeTests = []struct {
+	m      Money
+	stock  map[int64]int64
+	change map[int64]int64
+}{
+	{usd(18741), nil, map[int64]int64{10000: 1, 5000: 1, 2000: 1, 1000: 1, 500: 1, 200: 1, 25: 1, 10: 1, 5: 1, 1: 1}},
+	{usd(30), nil, map[int64]int64{25: 1, 5: 1}},
+	{usd(30), map[int64]int64{25: 1, 10: 5}, map[int64]int64{10: 3}},
+	{usd(4000), map[int64]int64{5000: 3, 2000: 1, 1000: 1, 500: 10}, map[int64]int64{2000: 1, 1000: 1, 500: 2}},
+	{FromMinorUnits(1234, currency.JPY), nil, map[int64]int64{1000: 1, 100: 2, 10: 3, 1: 4}},
+	{FromMinorUnits(325, currency.CHF), nil, map[int64]int64{200: 1, 100: 1, 20: 1, 5: 1}},
+	{FromMinorUnits(80, currency.NZD), map[int64]int64{50: 2, 20: 4}, map[int64]int64{20: 4}},
+	{usd(0), nil, map[int64]int64{}},
+}
+
+func TestMakeChange(t *testing.T) {
+	for i, test := range makeChangeTests {
+		change, err := MakeChange(test.m, test.stock)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if !reflect.DeepEqual(change, test.change) {
+			t.Errorf("[%d] expected %v got %v", i, test.change, change)
+		}
+	}
+}
+
+func TestMakeChangeErrors(t *testing.T) {
+	for i, test := range []struct {
+		m     Money
+		stock map[int64]int64
+	}{
+		{usd(-100), nil},
+		{usd(30), map[int64]int64{25: 1}},
+		{FromMinorUnits(80, currency.NZD), map[int64]int64{50: 2, 20: 3}},
+		{usd(30), map[int64]int64{25: 2, 3: 10}},
+		{usd(30), map[int64]int64{10: -1}},
+		{FromMinorUnits(327, currency.CHF), nil},
+		{FromMinorUnits(5, currency.NZD), nil},
+		{mustparse("0.001", "USD"), nil},
+		{FromMinorUnits(100, currency.ISK), nil},
+	} {
+		if _, err := MakeChange(test.m, test.stock); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
@@ -90,8 +90,6 @@ func main() {
 
 	w(f, "package cldr")
 	w(f, "")
-	w(f, `import "time"`)
-	w(f, "")
 	w(f, "// Generated from CLDR version %s", cf.Supplemental.Version.CLDRVersion)
 	w(f, "var CurrencyFractions = map[string]Fractions{")
 
@@ -130,15 +128,6 @@ func main() {
 	}
 	sort.Strings(regions)
 
-	w(f, `func dp(s string) *time.Time {`)
-	w(f, "\t"+`if t, err := time.Parse("2016-01-02", s); err != nil {`)
-	w(f, "\t\t"+`panic("invalid date")`)
-	w(f, "\t"+`} else {`)
-	w(f, "\t\t"+`return &t`)
-	w(f, "\t"+`}`)
-	w(f, `}`)
-	w(f, "")
-
 	w(f, "var RegionCurrencies = map[string][]RegionalUsage{")
 	for _, r := range regions {
 		w(f, "\t%q: {", r)
@@ -176,7 +165,7 @@ func defaults(a *int, b int) int {
 
 func fmtTime(sd *stringDate) string {
 	if sd == nil {
-		return "nil"
+		return "Date{}"
 	}
-	return fmt.Sprintf("dp(%q)", sd.t.Format("2006-01-02"))
+	return fmt.Sprintf("Date{%d, %d, %d}", sd.t.Year(), sd.t.Month(), sd.t.Day())
 }
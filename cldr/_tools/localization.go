@@ -2,9 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 )
@@ -20,73 +22,230 @@ type CurrenciesFile struct {
 		Numbers struct {
 			Currencies map[string]struct {
 				DisplayName           string `json:"displayName"`
+				DisplayNameCountZero  string `json:"displayName-count-zero"`
 				DisplayNameCountOne   string `json:"displayName-count-one"`
+				DisplayNameCountTwo   string `json:"displayName-count-two"`
+				DisplayNameCountFew   string `json:"displayName-count-few"`
+				DisplayNameCountMany  string `json:"displayName-count-many"`
 				DisplayNameCountOther string `json:"displayName-count-other"`
 				Symbol                string `json:"symbol"`
 				SymbolAltNarrow       string `json:"symbol-alt-narrow"`
 				SymbolAltVariant      string `json:"symbol-alt-variant"`
-			} `json:"currencies`
+			} `json:"currencies"`
 		} `json:"numbers"`
 	} `json:"main"`
 }
 
+type NumbersFile struct {
+	Main map[string]struct {
+		Numbers struct {
+			Symbols struct {
+				Decimal   string `json:"decimal"`
+				Group     string `json:"group"`
+				PlusSign  string `json:"plusSign"`
+				MinusSign string `json:"minusSign"`
+			} `json:"symbols-numberSystem-latn"`
+			CurrencyFormats struct {
+				Standard   string `json:"standard"`
+				Accounting string `json:"accounting"`
+			} `json:"currencyFormats-numberSystem-latn"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+type PluralsFile struct {
+	Supplemental struct {
+		Cardinal map[string]map[string]string `json:"plurals-type-cardinal"`
+	} `json:"supplemental"`
+}
+
+var only = flag.String("currencies", "", "comma-separated list of currencies to include (default all)")
+
 func main() {
-	if len(os.Args) != 2 {
-		fmt.Printf("usage: %s locale\n", os.Args[0])
+	flag.Usage = func() {
+		fmt.Printf("usage: %s [-currencies AAA,BBB] locale...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
 		os.Exit(1)
 	}
-	locale := os.Args[1]
 
-	url := fmt.Sprintf("https://raw.githubusercontent.com/unicode-cldr/cldr-numbers-modern/master/main/%s/currencies.json", locale)
+	var filter map[string]bool
+	if *only != "" {
+		filter = make(map[string]bool)
+		for _, s := range strings.Split(*only, ",") {
+			filter[s] = true
+		}
+	}
 
-	resp, err := http.Get(url)
-	if err != nil {
-		fmt.Printf("while downloading locale=%q from github: %v\n", locale, err)
-		os.Exit(2)
+	var pf PluralsFile
+	download("https://raw.githubusercontent.com/unicode-cldr/cldr-core/master/supplemental/plurals.json", &pf)
+
+	for _, locale := range flag.Args() {
+		generate(locale, filter, pf.Supplemental.Cardinal[strings.Replace(locale, "_", "-", -1)])
 	}
-	defer resp.Body.Close()
+	fmt.Println("now run gofmt on the generated files")
+}
+
+// generate writes the data for the given locale. English is compiled into the
+// cldr package itself, and every other locale is written to a subpackage named
+// after its language, which registers the locale when imported.
+func generate(locale string, filter map[string]bool, plurals map[string]string) {
+	// CLDR's JSON distribution uses BCP 47 locale identifiers
+	tag := strings.Replace(locale, "_", "-", -1)
+	base := "https://raw.githubusercontent.com/unicode-cldr/cldr-numbers-modern/master/main/" + tag
 
 	var cf CurrenciesFile
-	jd := json.NewDecoder(resp.Body)
-	if err := jd.Decode(&cf); err != nil {
-		fmt.Printf("while interpreting file: %v\n", err)
-		os.Exit(2)
-	}
+	download(base+"/currencies.json", &cf)
+	var nf NumbersFile
+	download(base+"/numbers.json", &nf)
 
+	lang := strings.SplitN(locale, "_", 2)[0]
+	pkg := "cldr"
 	fname := fmt.Sprintf("%s.go", locale)
+	varname := strings.ToUpper(strings.Replace(locale, "_", "", -1))
+	prefix := ""
+	if locale != "en" {
+		pkg = lang
+		fname = filepath.Join(lang, fname)
+		prefix = "cldr."
+		if err := os.MkdirAll(lang, 0755); err != nil {
+			fmt.Printf("while creating directory=%q: %v\n", lang, err)
+			os.Exit(2)
+		}
+	}
+
 	f, err := os.Create(fname)
 	if err != nil {
 		fmt.Printf("while opening output file=%q for writing: %v\n", fname, err)
 		os.Exit(2)
 	}
+	defer f.Close()
+
+	numbers := nf.Main[tag].Numbers
+	symbols := keyed(
+		"Decimal", numbers.Symbols.Decimal,
+		"Group", numbers.Symbols.Group,
+		"PlusSign", numbers.Symbols.PlusSign,
+		"MinusSign", numbers.Symbols.MinusSign,
+	)
+	formats := keyed(
+		"Standard", numbers.CurrencyFormats.Standard,
+		"Accounting", numbers.CurrencyFormats.Accounting,
+	)
+	// Plural rules are usually only defined for languages, and are
+	// inherited by their regional locales
+	rules := keyed(
+		"Zero", pluralRule(plurals["pluralRule-count-zero"]),
+		"One", pluralRule(plurals["pluralRule-count-one"]),
+		"Two", pluralRule(plurals["pluralRule-count-two"]),
+		"Few", pluralRule(plurals["pluralRule-count-few"]),
+		"Many", pluralRule(plurals["pluralRule-count-many"]),
+	)
 
-	w(f, "package cldr")
+	w(f, "package %s", pkg)
+	w(f, "")
+	if locale == "en" {
+		w(f, "var enLocale = &Locale{")
+	} else {
+		w(f, `import "github.com/zenazn/money/cldr"`)
+		w(f, "")
+		w(f, "func init() {")
+		w(f, "cldr.Register(&cldr.Locale{")
+	}
+	w(f, "ID: %q,", locale)
+	w(f, "Currencies: %s,", varname)
+	w(f, "Symbols: %sNumberSymbols{%s},", prefix, symbols)
+	w(f, "CurrencyFormats: %sCurrencyFormats{%s},", prefix, formats)
+	if rules != "" {
+		w(f, "PluralRules: %sPluralRules{%s},", prefix, rules)
+	}
+	if locale == "en" {
+		w(f, "}")
+	} else {
+		w(f, "})")
+		w(f, "}")
+	}
 	w(f, "")
-	w(f, "// Generated from CLDR version %s", cf.Main[locale].Identity.Version.CLDRVersion)
-	w(f, "var Locale%s = map[string]Localization{", strings.ToUpper(locale))
 
-	currencies := make([]string, 0, len(cf.Main[locale].Numbers.Currencies))
-	for s := range cf.Main[locale].Numbers.Currencies {
-		currencies = append(currencies, s)
+	currencies := make([]string, 0, len(cf.Main[tag].Numbers.Currencies))
+	for s := range cf.Main[tag].Numbers.Currencies {
+		if filter == nil || filter[s] {
+			currencies = append(currencies, s)
+		}
 	}
 	sort.Strings(currencies)
 
+	version := cf.Main[tag].Identity.Version.CLDRVersion
+	if filter != nil {
+		fcurrencies := make([]string, 0, len(filter))
+		for s := range filter {
+			fcurrencies = append(fcurrencies, s)
+		}
+		sort.Strings(fcurrencies)
+		w(f, "// Generated from CLDR version %s (currencies: %s)", version, strings.Join(fcurrencies, ", "))
+	} else {
+		w(f, "// Generated from CLDR version %s", version)
+	}
+	w(f, "var %s = map[string]%sLocalization{", varname, prefix)
+
 	for _, s := range currencies {
-		cdata := cf.Main[locale].Numbers.Currencies[s]
-		w(f, "\t%q: {%q, %q, %q, %q, %q, %q},",
-			s,
-			cdata.DisplayName,
-			cdata.DisplayNameCountOne,
-			cdata.DisplayNameCountOther,
-			cdata.Symbol,
-			cdata.SymbolAltNarrow,
-			cdata.SymbolAltVariant,
+		cdata := cf.Main[tag].Numbers.Currencies[s]
+		// Omit empty fields, which are inherited from the parent
+		// locale
+		fields := keyed(
+			"DisplayName", cdata.DisplayName,
+			"DisplayNameCountZero", cdata.DisplayNameCountZero,
+			"DisplayNameCountOne", cdata.DisplayNameCountOne,
+			"DisplayNameCountTwo", cdata.DisplayNameCountTwo,
+			"DisplayNameCountFew", cdata.DisplayNameCountFew,
+			"DisplayNameCountMany", cdata.DisplayNameCountMany,
+			"DisplayNameCountOther", cdata.DisplayNameCountOther,
+			"Symbol", cdata.Symbol,
+			"SymbolAltNarrow", cdata.SymbolAltNarrow,
+			"SymbolAltVariant", cdata.SymbolAltVariant,
 		)
+		w(f, "\t%q: {%s},", s, fields)
 	}
 
 	w(f, "}")
 }
 
+func download(url string, v interface{}) {
+	resp, err := http.Get(url)
+	if err != nil {
+		fmt.Printf("while downloading %q: %v\n", url, err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	jd := json.NewDecoder(resp.Body)
+	if err := jd.Decode(v); err != nil {
+		fmt.Printf("while interpreting %q: %v\n", url, err)
+		os.Exit(2)
+	}
+}
+
+// pluralRule strips the samples from a CLDR plural rule.
+func pluralRule(s string) string {
+	if i := strings.IndexByte(s, '@'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+func keyed(kvs ...string) string {
+	var fields []string
+	for i := 0; i < len(kvs); i += 2 {
+		if kvs[i+1] != "" {
+			fields = append(fields, fmt.Sprintf("%s: %q", kvs[i], kvs[i+1]))
+		}
+	}
+	return strings.Join(fields, ", ")
+}
+
 func w(f *os.File, s string, args ...interface{}) {
 	_, err := fmt.Fprintf(f, s+"\n", args...)
 	if err != nil {
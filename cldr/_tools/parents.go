@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+type ParentLocalesFile struct {
+	Supplemental struct {
+		Version struct {
+			Number      string `json:"_number"`
+			CLDRVersion string `json:"_cldrVersion"`
+		} `json:"version"`
+		ParentLocales struct {
+			ParentLocale map[string]string `json:"parentLocale"`
+		} `json:"parentLocales"`
+	} `json:"supplemental"`
+}
+
+func main() {
+	resp, err := http.Get("https://raw.githubusercontent.com/unicode-cldr/cldr-core/master/supplemental/parentLocales.json")
+	if err != nil {
+		fmt.Printf("while downloading parent locales from github: %v\n", err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	var pf ParentLocalesFile
+	jd := json.NewDecoder(resp.Body)
+	if err := jd.Decode(&pf); err != nil {
+		fmt.Printf("while interpreting file: %v\n", err)
+		os.Exit(2)
+	}
+
+	f, err := os.Create("parents.go")
+	if err != nil {
+		fmt.Printf("while opening output file=%q for writing: %v\n", "parents.go", err)
+		os.Exit(2)
+	}
+	defer f.Close()
+
+	children := make([]string, 0, len(pf.Supplemental.ParentLocales.ParentLocale))
+	for c := range pf.Supplemental.ParentLocales.ParentLocale {
+		children = append(children, c)
+	}
+	sort.Strings(children)
+
+	w(f, "package cldr")
+	w(f, "")
+	w(f, "// Generated from CLDR version %s", pf.Supplemental.Version.CLDRVersion)
+	w(f, "var parentLocales = map[string]string{")
+	for _, c := range children {
+		p := pf.Supplemental.ParentLocales.ParentLocale[c]
+		w(f, "\t%q: %q,", underscore(c), underscore(p))
+	}
+	w(f, "}")
+}
+
+func underscore(s string) string {
+	return strings.Replace(s, "-", "_", -1)
+}
+
+func w(f *os.File, s string, args ...interface{}) {
+	_, err := fmt.Fprintf(f, s+"\n", args...)
+	if err != nil {
+		fmt.Printf("while writing %q: %v\n", f.Name(), err)
+		os.Exit(2)
+	}
+}
@@ -1,18 +1,53 @@
 // Package cldr contains currency data from the Unicode Common Locale Data
 // Repository (CLDR).
+//
+// Localizations for English are always available. To keep binaries small, data
+// for other locales lives in one subpackage per language, which must be
+// imported to make its locales available to Lookup:
+//
+//	import _ "github.com/zenazn/money/cldr/de"
 package cldr
 
 import "time"
 
+// Localization contains the localized names and symbols of a currency. The
+// DisplayNameCount fields contain the display name to use with amounts in each
+// plural category; most languages only use some of them.
 type Localization struct {
 	DisplayName           string
+	DisplayNameCountZero  string
 	DisplayNameCountOne   string
+	DisplayNameCountTwo   string
+	DisplayNameCountFew   string
+	DisplayNameCountMany  string
 	DisplayNameCountOther string
 	Symbol                string
 	SymbolAltNarrow       string
 	SymbolAltVariant      string
 }
 
+// DisplayNameCount returns the display name to use with amounts in the given
+// plural category. If there is no display name for that category, it falls
+// back to the display name for PluralOther, and then to DisplayName.
+func (l Localization) DisplayNameCount(c PluralCategory) string {
+	var s string
+	switch c {
+	case PluralZero:
+		s = l.DisplayNameCountZero
+	case PluralOne:
+		s = l.DisplayNameCountOne
+	case PluralTwo:
+		s = l.DisplayNameCountTwo
+	case PluralFew:
+		s = l.DisplayNameCountFew
+	case PluralMany:
+		s = l.DisplayNameCountMany
+	}
+	fill(&s, l.DisplayNameCountOther)
+	fill(&s, l.DisplayName)
+	return s
+}
+
 type Fractions struct {
 	Rounding     int
 	Digits       int
@@ -20,9 +55,43 @@ type Fractions struct {
 	CashDigits   int
 }
 
+// Date is a calendar date. The zero Date is used to represent an unbounded end
+// of a date range.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// IsZero returns true if the date is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Time returns the time at midnight UTC at the start of the given date.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// RegionalUsage describes a period during which a currency was used in a
+// region. The period starts at the beginning of From and ends just before the
+// beginning of To. A zero From or To indicates that the period is unbounded in
+// that direction.
 type RegionalUsage struct {
 	Symbol string
-	From   *time.Time
-	To     *time.Time
+	From   Date
+	To     Date
 	Tender bool
 }
+
+// ActiveAt returns true if the currency was in use in the region at the given
+// time.
+func (r RegionalUsage) ActiveAt(t time.Time) bool {
+	if !r.From.IsZero() && t.Before(r.From.Time()) {
+		return false
+	}
+	if !r.To.IsZero() && !t.Before(r.To.Time()) {
+		return false
+	}
+	return true
+}
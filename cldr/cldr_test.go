@@ -0,0 +1,65 @@
+package cldr
+
+import (
+	"testing"
+	"time"
+)
+
+func active(region string, t time.Time) []string {
+	var out []string
+	for _, ru := range RegionCurrencies[region] {
+		if ru.Tender && ru.ActiveAt(t) {
+			out = append(out, ru.Symbol)
+		}
+	}
+	return out
+}
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+var transitionTests = []struct {
+	region string
+	t      time.Time
+	ccys   []string
+}{
+	{"AD", day(1998, 12, 31), []string{"ESP", "ADP", "FRF"}},
+	{"AD", day(1999, 1, 1), []string{"ESP", "ADP", "FRF", "EUR"}},
+	{"AD", day(2002, 3, 1), []string{"EUR"}},
+	{"AD", day(2002, 2, 28), []string{"ESP", "EUR"}},
+	{"ST", day(2017, 12, 31), []string{"STD"}},
+	{"ST", day(2018, 1, 1), []string{"STN"}},
+	{"VE", day(2018, 8, 20), []string{"VEF", "VES"}},
+	{"VE", day(2018, 8, 21), []string{"VES"}},
+	{"US", day(2019, 1, 1), []string{"USD"}},
+}
+
+func TestRegionTransitions(t *testing.T) {
+	for i, test := range transitionTests {
+		ccys := active(test.region, test.t)
+		if len(ccys) != len(test.ccys) {
+			t.Errorf("[%d] expected %v got %v", i, test.ccys, ccys)
+			continue
+		}
+		for j := range ccys {
+			if ccys[j] != test.ccys[j] {
+				t.Errorf("[%d] expected %v got %v", i, test.ccys, ccys)
+				break
+			}
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	eur := RegionCurrencies["AD"][3]
+	if eur.Symbol != "EUR" {
+		t.Fatalf("unexpected currency %q", eur.Symbol)
+	}
+	if d := eur.From.Time(); !d.Equal(day(1999, 1, 1)) {
+		t.Errorf("from %v", d)
+	}
+	if !eur.To.IsZero() {
+		t.Errorf("to %v", eur.To)
+	}
+}
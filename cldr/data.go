@@ -1,7 +1,5 @@
 package cldr
 
-import "time"
-
 // Generated from CLDR version 35
 var CurrencyFractions = map[string]Fractions{
 	"ADP": {0, 0, 0, 0},
@@ -79,1044 +77,1036 @@ var CurrencyFractions = map[string]Fractions{
 
 var DefaultFractions = Fractions{0, 2, 0, 2}
 
-func dp(s string) *time.Time {
-	if t, err := time.Parse("2016-01-02", s); err != nil {
-		panic("invalid date")
-	} else {
-		return &t
-	}
-}
-
 var RegionCurrencies = map[string][]RegionalUsage{
 	"AC": {
-		{"SHP", dp("1976-01-01"), nil, true},
+		{"SHP", Date{1976, 1, 1}, Date{}, true},
 	},
 	"AD": {
-		{"ESP", dp("1873-01-01"), dp("2002-03-01"), true},
-		{"ADP", dp("1936-01-01"), dp("2002-01-01"), true},
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ESP", Date{1873, 1, 1}, Date{2002, 3, 1}, true},
+		{"ADP", Date{1936, 1, 1}, Date{2002, 1, 1}, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"AE": {
-		{"AED", dp("1973-05-19"), nil, true},
+		{"AED", Date{1973, 5, 19}, Date{}, true},
 	},
 	"AF": {
-		{"AFA", dp("1927-03-14"), dp("2003-01-01"), true},
-		{"AFN", dp("2002-10-07"), nil, true},
+		{"AFA", Date{1927, 3, 14}, Date{2003, 1, 1}, true},
+		{"AFN", Date{2002, 10, 7}, Date{}, true},
 	},
 	"AG": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"AI": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"AL": {
-		{"ALK", dp("1946-11-01"), dp("1965-08-17"), true},
-		{"ALL", dp("1965-08-16"), nil, true},
+		{"ALK", Date{1946, 11, 1}, Date{1965, 8, 17}, true},
+		{"ALL", Date{1965, 8, 16}, Date{}, true},
 	},
 	"AM": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1993-11-23"), true},
-		{"AMD", dp("1993-11-22"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1993, 11, 23}, true},
+		{"AMD", Date{1993, 11, 22}, Date{}, true},
 	},
 	"AO": {
-		{"AOK", dp("1977-01-08"), dp("1991-03-02"), true},
-		{"AON", dp("1990-09-25"), dp("2000-02-02"), true},
-		{"AOR", dp("1995-07-01"), dp("2000-02-02"), true},
-		{"AOA", dp("1999-12-13"), nil, true},
+		{"AOK", Date{1977, 1, 8}, Date{1991, 3, 2}, true},
+		{"AON", Date{1990, 9, 25}, Date{2000, 2, 2}, true},
+		{"AOR", Date{1995, 7, 1}, Date{2000, 2, 2}, true},
+		{"AOA", Date{1999, 12, 13}, Date{}, true},
 	},
 	"AQ": {
-		{"XXX", nil, nil, false},
+		{"XXX", Date{}, Date{}, false},
 	},
 	"AR": {
-		{"ARM", dp("1881-11-05"), dp("1970-01-02"), true},
-		{"ARL", dp("1970-01-01"), dp("1983-06-02"), true},
-		{"ARP", dp("1983-06-01"), dp("1985-06-15"), true},
-		{"ARA", dp("1985-06-14"), dp("1992-01-02"), true},
-		{"ARS", dp("1992-01-01"), nil, true},
+		{"ARM", Date{1881, 11, 5}, Date{1970, 1, 2}, true},
+		{"ARL", Date{1970, 1, 1}, Date{1983, 6, 2}, true},
+		{"ARP", Date{1983, 6, 1}, Date{1985, 6, 15}, true},
+		{"ARA", Date{1985, 6, 14}, Date{1992, 1, 2}, true},
+		{"ARS", Date{1992, 1, 1}, Date{}, true},
 	},
 	"AS": {
-		{"USD", dp("1904-07-16"), nil, true},
+		{"USD", Date{1904, 7, 16}, Date{}, true},
 	},
 	"AT": {
-		{"ATS", dp("1947-12-04"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ATS", Date{1947, 12, 4}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"AU": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"AW": {
-		{"ANG", dp("1940-05-10"), dp("1986-01-02"), true},
-		{"AWG", dp("1986-01-01"), nil, true},
+		{"ANG", Date{1940, 5, 10}, Date{1986, 1, 2}, true},
+		{"AWG", Date{1986, 1, 1}, Date{}, true},
 	},
 	"AX": {
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"AZ": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1994-01-02"), true},
-		{"AZM", dp("1993-11-22"), dp("2007-01-01"), true},
-		{"AZN", dp("2006-01-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1994, 1, 2}, true},
+		{"AZM", Date{1993, 11, 22}, Date{2007, 1, 1}, true},
+		{"AZN", Date{2006, 1, 1}, Date{}, true},
 	},
 	"BA": {
-		{"YUD", dp("1966-01-01"), dp("1990-01-02"), true},
-		{"YUN", dp("1990-01-01"), dp("1992-07-02"), true},
-		{"YUR", dp("1992-07-01"), dp("1993-10-02"), true},
-		{"BAD", dp("1992-07-01"), dp("1994-08-16"), true},
-		{"BAN", dp("1994-08-15"), dp("1997-07-02"), true},
-		{"BAM", dp("1995-01-01"), nil, true},
+		{"YUD", Date{1966, 1, 1}, Date{1990, 1, 2}, true},
+		{"YUN", Date{1990, 1, 1}, Date{1992, 7, 2}, true},
+		{"YUR", Date{1992, 7, 1}, Date{1993, 10, 2}, true},
+		{"BAD", Date{1992, 7, 1}, Date{1994, 8, 16}, true},
+		{"BAN", Date{1994, 8, 15}, Date{1997, 7, 2}, true},
+		{"BAM", Date{1995, 1, 1}, Date{}, true},
 	},
 	"BB": {
-		{"XCD", dp("1965-10-06"), dp("1973-12-04"), true},
-		{"BBD", dp("1973-12-03"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{1973, 12, 4}, true},
+		{"BBD", Date{1973, 12, 3}, Date{}, true},
 	},
 	"BD": {
-		{"INR", dp("1835-08-17"), dp("1948-04-02"), true},
-		{"PKR", dp("1948-04-01"), dp("1972-01-02"), true},
-		{"BDT", dp("1972-01-01"), nil, true},
+		{"INR", Date{1835, 8, 17}, Date{1948, 4, 2}, true},
+		{"PKR", Date{1948, 4, 1}, Date{1972, 1, 2}, true},
+		{"BDT", Date{1972, 1, 1}, Date{}, true},
 	},
 	"BE": {
-		{"NLG", dp("1816-12-15"), dp("1831-02-08"), true},
-		{"BEF", dp("1831-02-07"), dp("2002-03-01"), true},
-		{"BEC", dp("1970-01-01"), dp("1990-03-06"), false},
-		{"BEL", dp("1970-01-01"), dp("1990-03-06"), false},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"NLG", Date{1816, 12, 15}, Date{1831, 2, 8}, true},
+		{"BEF", Date{1831, 2, 7}, Date{2002, 3, 1}, true},
+		{"BEC", Date{1970, 1, 1}, Date{1990, 3, 6}, false},
+		{"BEL", Date{1970, 1, 1}, Date{1990, 3, 6}, false},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"BF": {
-		{"XOF", dp("1984-08-04"), nil, true},
+		{"XOF", Date{1984, 8, 4}, Date{}, true},
 	},
 	"BG": {
-		{"BGO", dp("1879-07-08"), dp("1952-05-13"), true},
-		{"BGM", dp("1952-05-12"), dp("1962-01-02"), true},
-		{"BGL", dp("1962-01-01"), dp("1999-07-06"), true},
-		{"BGN", dp("1999-07-05"), nil, true},
+		{"BGO", Date{1879, 7, 8}, Date{1952, 5, 13}, true},
+		{"BGM", Date{1952, 5, 12}, Date{1962, 1, 2}, true},
+		{"BGL", Date{1962, 1, 1}, Date{1999, 7, 6}, true},
+		{"BGN", Date{1999, 7, 5}, Date{}, true},
 	},
 	"BH": {
-		{"BHD", dp("1965-10-16"), nil, true},
+		{"BHD", Date{1965, 10, 16}, Date{}, true},
 	},
 	"BI": {
-		{"BIF", dp("1964-05-19"), nil, true},
+		{"BIF", Date{1964, 5, 19}, Date{}, true},
 	},
 	"BJ": {
-		{"XOF", dp("1975-11-30"), nil, true},
+		{"XOF", Date{1975, 11, 30}, Date{}, true},
 	},
 	"BL": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"BM": {
-		{"BMD", dp("1970-02-06"), nil, true},
+		{"BMD", Date{1970, 2, 6}, Date{}, true},
 	},
 	"BN": {
-		{"MYR", dp("1963-09-16"), dp("1967-06-13"), true},
-		{"BND", dp("1967-06-12"), nil, true},
+		{"MYR", Date{1963, 9, 16}, Date{1967, 6, 13}, true},
+		{"BND", Date{1967, 6, 12}, Date{}, true},
 	},
 	"BO": {
-		{"BOV", nil, nil, false},
-		{"BOL", dp("1863-06-23"), dp("1963-01-02"), true},
-		{"BOP", dp("1963-01-01"), dp("1987-01-01"), true},
-		{"BOB", dp("1987-01-01"), nil, true},
+		{"BOV", Date{}, Date{}, false},
+		{"BOL", Date{1863, 6, 23}, Date{1963, 1, 2}, true},
+		{"BOP", Date{1963, 1, 1}, Date{1987, 1, 1}, true},
+		{"BOB", Date{1987, 1, 1}, Date{}, true},
 	},
 	"BQ": {
-		{"ANG", dp("2010-10-10"), dp("2011-01-02"), true},
-		{"USD", dp("2011-01-01"), nil, true},
+		{"ANG", Date{2010, 10, 10}, Date{2011, 1, 2}, true},
+		{"USD", Date{2011, 1, 1}, Date{}, true},
 	},
 	"BR": {
-		{"BRZ", dp("1942-11-01"), dp("1967-02-14"), true},
-		{"BRB", dp("1967-02-13"), dp("1986-03-01"), true},
-		{"BRC", dp("1986-02-28"), dp("1989-01-16"), true},
-		{"BRN", dp("1989-01-15"), dp("1990-03-17"), true},
-		{"BRE", dp("1990-03-16"), dp("1993-08-02"), true},
-		{"BRR", dp("1993-08-01"), dp("1994-07-02"), true},
-		{"BRL", dp("1994-07-01"), nil, true},
+		{"BRZ", Date{1942, 11, 1}, Date{1967, 2, 14}, true},
+		{"BRB", Date{1967, 2, 13}, Date{1986, 3, 1}, true},
+		{"BRC", Date{1986, 2, 28}, Date{1989, 1, 16}, true},
+		{"BRN", Date{1989, 1, 15}, Date{1990, 3, 17}, true},
+		{"BRE", Date{1990, 3, 16}, Date{1993, 8, 2}, true},
+		{"BRR", Date{1993, 8, 1}, Date{1994, 7, 2}, true},
+		{"BRL", Date{1994, 7, 1}, Date{}, true},
 	},
 	"BS": {
-		{"BSD", dp("1966-05-25"), nil, true},
+		{"BSD", Date{1966, 5, 25}, Date{}, true},
 	},
 	"BT": {
-		{"INR", dp("1907-01-01"), nil, true},
-		{"BTN", dp("1974-04-16"), nil, true},
+		{"INR", Date{1907, 1, 1}, Date{}, true},
+		{"BTN", Date{1974, 4, 16}, Date{}, true},
 	},
 	"BU": {
-		{"BUK", dp("1952-07-01"), dp("1989-06-19"), true},
+		{"BUK", Date{1952, 7, 1}, Date{1989, 6, 19}, true},
 	},
 	"BV": {
-		{"NOK", dp("1905-06-07"), nil, true},
+		{"NOK", Date{1905, 6, 7}, Date{}, true},
 	},
 	"BW": {
-		{"ZAR", dp("1961-02-14"), dp("1976-08-24"), true},
-		{"BWP", dp("1976-08-23"), nil, true},
+		{"ZAR", Date{1961, 2, 14}, Date{1976, 8, 24}, true},
+		{"BWP", Date{1976, 8, 23}, Date{}, true},
 	},
 	"BY": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1994-11-09"), true},
-		{"BYB", dp("1994-08-01"), dp("2001-01-01"), true},
-		{"BYR", dp("2000-01-01"), dp("2017-01-02"), true},
-		{"BYN", dp("2016-07-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1994, 11, 9}, true},
+		{"BYB", Date{1994, 8, 1}, Date{2001, 1, 1}, true},
+		{"BYR", Date{2000, 1, 1}, Date{2017, 1, 2}, true},
+		{"BYN", Date{2016, 7, 1}, Date{}, true},
 	},
 	"BZ": {
-		{"BZD", dp("1974-01-01"), nil, true},
+		{"BZD", Date{1974, 1, 1}, Date{}, true},
 	},
 	"CA": {
-		{"CAD", dp("1858-01-01"), nil, true},
+		{"CAD", Date{1858, 1, 1}, Date{}, true},
 	},
 	"CC": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"CD": {
-		{"ZRZ", dp("1971-10-27"), dp("1993-11-02"), true},
-		{"ZRN", dp("1993-11-01"), dp("1998-07-02"), true},
-		{"CDF", dp("1998-07-01"), nil, true},
+		{"ZRZ", Date{1971, 10, 27}, Date{1993, 11, 2}, true},
+		{"ZRN", Date{1993, 11, 1}, Date{1998, 7, 2}, true},
+		{"CDF", Date{1998, 7, 1}, Date{}, true},
 	},
 	"CF": {
-		{"XAF", dp("1993-01-01"), nil, true},
+		{"XAF", Date{1993, 1, 1}, Date{}, true},
 	},
 	"CG": {
-		{"XAF", dp("1993-01-01"), nil, true},
+		{"XAF", Date{1993, 1, 1}, Date{}, true},
 	},
 	"CH": {
-		{"CHE", nil, nil, false},
-		{"CHW", nil, nil, false},
-		{"CHF", dp("1799-03-17"), nil, true},
+		{"CHE", Date{}, Date{}, false},
+		{"CHW", Date{}, Date{}, false},
+		{"CHF", Date{1799, 3, 17}, Date{}, true},
 	},
 	"CI": {
-		{"XOF", dp("1958-12-04"), nil, true},
+		{"XOF", Date{1958, 12, 4}, Date{}, true},
 	},
 	"CK": {
-		{"NZD", dp("1967-07-10"), nil, true},
+		{"NZD", Date{1967, 7, 10}, Date{}, true},
 	},
 	"CL": {
-		{"CLF", nil, nil, false},
-		{"CLE", dp("1960-01-01"), dp("1975-09-30"), true},
-		{"CLP", dp("1975-09-29"), nil, true},
+		{"CLF", Date{}, Date{}, false},
+		{"CLE", Date{1960, 1, 1}, Date{1975, 9, 30}, true},
+		{"CLP", Date{1975, 9, 29}, Date{}, true},
 	},
 	"CM": {
-		{"XAF", dp("1973-04-01"), nil, true},
+		{"XAF", Date{1973, 4, 1}, Date{}, true},
 	},
 	"CN": {
-		{"CNY", dp("1953-03-01"), nil, true},
-		{"CNX", dp("1979-01-01"), dp("1999-01-01"), false},
-		{"CNH", dp("2010-07-19"), nil, false},
+		{"CNY", Date{1953, 3, 1}, Date{}, true},
+		{"CNX", Date{1979, 1, 1}, Date{1999, 1, 1}, false},
+		{"CNH", Date{2010, 7, 19}, Date{}, false},
 	},
 	"CO": {
-		{"COU", nil, nil, false},
-		{"COP", dp("1905-01-01"), nil, true},
+		{"COU", Date{}, Date{}, false},
+		{"COP", Date{1905, 1, 1}, Date{}, true},
 	},
 	"CP": {
-		{"XXX", nil, nil, false},
+		{"XXX", Date{}, Date{}, false},
 	},
 	"CR": {
-		{"CRC", dp("1896-10-26"), nil, true},
+		{"CRC", Date{1896, 10, 26}, Date{}, true},
 	},
 	"CS": {
-		{"YUM", dp("1994-01-24"), dp("2002-05-16"), true},
-		{"CSD", dp("2002-05-15"), dp("2006-06-04"), true},
-		{"EUR", dp("2003-02-04"), dp("2006-06-04"), true},
+		{"YUM", Date{1994, 1, 24}, Date{2002, 5, 16}, true},
+		{"CSD", Date{2002, 5, 15}, Date{2006, 6, 4}, true},
+		{"EUR", Date{2003, 2, 4}, Date{2006, 6, 4}, true},
 	},
 	"CU": {
-		{"CUP", dp("1859-01-01"), nil, true},
-		{"USD", dp("1899-01-01"), dp("1959-01-02"), true},
-		{"CUC", dp("1994-01-01"), nil, true},
+		{"CUP", Date{1859, 1, 1}, Date{}, true},
+		{"USD", Date{1899, 1, 1}, Date{1959, 1, 2}, true},
+		{"CUC", Date{1994, 1, 1}, Date{}, true},
 	},
 	"CV": {
-		{"PTE", dp("1911-05-22"), dp("1975-07-06"), true},
-		{"CVE", dp("1914-01-01"), nil, true},
+		{"PTE", Date{1911, 5, 22}, Date{1975, 7, 6}, true},
+		{"CVE", Date{1914, 1, 1}, Date{}, true},
 	},
 	"CW": {
-		{"ANG", dp("2010-10-10"), nil, true},
+		{"ANG", Date{2010, 10, 10}, Date{}, true},
 	},
 	"CX": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"CY": {
-		{"CYP", dp("1914-09-10"), dp("2008-02-01"), true},
-		{"EUR", dp("2008-01-01"), nil, true},
+		{"CYP", Date{1914, 9, 10}, Date{2008, 2, 1}, true},
+		{"EUR", Date{2008, 1, 1}, Date{}, true},
 	},
 	"CZ": {
-		{"CSK", dp("1953-06-01"), dp("1993-03-02"), true},
-		{"CZK", dp("1993-01-01"), nil, true},
+		{"CSK", Date{1953, 6, 1}, Date{1993, 3, 2}, true},
+		{"CZK", Date{1993, 1, 1}, Date{}, true},
 	},
 	"DD": {
-		{"DDM", dp("1948-07-20"), dp("1990-10-03"), true},
+		{"DDM", Date{1948, 7, 20}, Date{1990, 10, 3}, true},
 	},
 	"DE": {
-		{"DEM", dp("1948-06-20"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"DEM", Date{1948, 6, 20}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"DG": {
-		{"USD", dp("1965-11-08"), nil, true},
+		{"USD", Date{1965, 11, 8}, Date{}, true},
 	},
 	"DJ": {
-		{"DJF", dp("1977-06-27"), nil, true},
+		{"DJF", Date{1977, 6, 27}, Date{}, true},
 	},
 	"DK": {
-		{"DKK", dp("1873-05-27"), nil, true},
+		{"DKK", Date{1873, 5, 27}, Date{}, true},
 	},
 	"DM": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"DO": {
-		{"USD", dp("1905-06-21"), dp("1947-10-02"), true},
-		{"DOP", dp("1947-10-01"), nil, true},
+		{"USD", Date{1905, 6, 21}, Date{1947, 10, 2}, true},
+		{"DOP", Date{1947, 10, 1}, Date{}, true},
 	},
 	"DZ": {
-		{"DZD", dp("1964-04-01"), nil, true},
+		{"DZD", Date{1964, 4, 1}, Date{}, true},
 	},
 	"EA": {
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"EC": {
-		{"ECS", dp("1884-04-01"), dp("2000-10-03"), true},
-		{"ECV", dp("1993-05-23"), dp("2000-01-10"), false},
-		{"USD", dp("2000-10-02"), nil, true},
+		{"ECS", Date{1884, 4, 1}, Date{2000, 10, 3}, true},
+		{"ECV", Date{1993, 5, 23}, Date{2000, 1, 10}, false},
+		{"USD", Date{2000, 10, 2}, Date{}, true},
 	},
 	"EE": {
-		{"SUR", dp("1961-01-01"), dp("1992-06-21"), true},
-		{"EEK", dp("1992-06-21"), dp("2011-01-01"), true},
-		{"EUR", dp("2011-01-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1992, 6, 21}, true},
+		{"EEK", Date{1992, 6, 21}, Date{2011, 1, 1}, true},
+		{"EUR", Date{2011, 1, 1}, Date{}, true},
 	},
 	"EG": {
-		{"EGP", dp("1885-11-14"), nil, true},
+		{"EGP", Date{1885, 11, 14}, Date{}, true},
 	},
 	"EH": {
-		{"MAD", dp("1976-02-26"), nil, true},
+		{"MAD", Date{1976, 2, 26}, Date{}, true},
 	},
 	"ER": {
-		{"ETB", dp("1993-05-24"), dp("1997-11-09"), true},
-		{"ERN", dp("1997-11-08"), nil, true},
+		{"ETB", Date{1993, 5, 24}, Date{1997, 11, 9}, true},
+		{"ERN", Date{1997, 11, 8}, Date{}, true},
 	},
 	"ES": {
-		{"ESP", dp("1868-10-19"), dp("2002-03-01"), true},
-		{"ESB", dp("1975-01-01"), dp("1995-01-01"), false},
-		{"ESA", dp("1978-01-01"), dp("1982-01-01"), false},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ESP", Date{1868, 10, 19}, Date{2002, 3, 1}, true},
+		{"ESB", Date{1975, 1, 1}, Date{1995, 1, 1}, false},
+		{"ESA", Date{1978, 1, 1}, Date{1982, 1, 1}, false},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"ET": {
-		{"ETB", dp("1976-09-15"), nil, true},
+		{"ETB", Date{1976, 9, 15}, Date{}, true},
 	},
 	"EU": {
-		{"XEU", dp("1979-01-01"), dp("1999-01-01"), false},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"XEU", Date{1979, 1, 1}, Date{1999, 1, 1}, false},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"FI": {
-		{"FIM", dp("1963-01-01"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FIM", Date{1963, 1, 1}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"FJ": {
-		{"FJD", dp("1969-01-13"), nil, true},
+		{"FJD", Date{1969, 1, 13}, Date{}, true},
 	},
 	"FK": {
-		{"FKP", dp("1901-01-01"), nil, true},
+		{"FKP", Date{1901, 1, 1}, Date{}, true},
 	},
 	"FM": {
-		{"JPY", dp("1914-10-03"), dp("1944-01-02"), true},
-		{"USD", dp("1944-01-01"), nil, true},
+		{"JPY", Date{1914, 10, 3}, Date{1944, 1, 2}, true},
+		{"USD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"FO": {
-		{"DKK", dp("1948-01-01"), nil, true},
+		{"DKK", Date{1948, 1, 1}, Date{}, true},
 	},
 	"FR": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"GA": {
-		{"XAF", dp("1993-01-01"), nil, true},
+		{"XAF", Date{1993, 1, 1}, Date{}, true},
 	},
 	"GB": {
-		{"GBP", dp("1694-07-27"), nil, true},
+		{"GBP", Date{1694, 7, 27}, Date{}, true},
 	},
 	"GD": {
-		{"XCD", dp("1967-02-27"), nil, true},
+		{"XCD", Date{1967, 2, 27}, Date{}, true},
 	},
 	"GE": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1993-06-12"), true},
-		{"GEK", dp("1993-04-05"), dp("1995-09-26"), true},
-		{"GEL", dp("1995-09-23"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1993, 6, 12}, true},
+		{"GEK", Date{1993, 4, 5}, Date{1995, 9, 26}, true},
+		{"GEL", Date{1995, 9, 23}, Date{}, true},
 	},
 	"GF": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"GG": {
-		{"GBP", dp("1830-01-01"), nil, true},
+		{"GBP", Date{1830, 1, 1}, Date{}, true},
 	},
 	"GH": {
-		{"GHC", dp("1979-03-09"), dp("2008-01-01"), true},
-		{"GHS", dp("2007-07-03"), nil, true},
+		{"GHC", Date{1979, 3, 9}, Date{2008, 1, 1}, true},
+		{"GHS", Date{2007, 7, 3}, Date{}, true},
 	},
 	"GI": {
-		{"GIP", dp("1713-01-01"), nil, true},
+		{"GIP", Date{1713, 1, 1}, Date{}, true},
 	},
 	"GL": {
-		{"DKK", dp("1873-05-27"), nil, true},
+		{"DKK", Date{1873, 5, 27}, Date{}, true},
 	},
 	"GM": {
-		{"GMD", dp("1971-07-01"), nil, true},
+		{"GMD", Date{1971, 7, 1}, Date{}, true},
 	},
 	"GN": {
-		{"GNS", dp("1972-10-02"), dp("1986-01-07"), true},
-		{"GNF", dp("1986-01-06"), nil, true},
+		{"GNS", Date{1972, 10, 2}, Date{1986, 1, 7}, true},
+		{"GNF", Date{1986, 1, 6}, Date{}, true},
 	},
 	"GP": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"GQ": {
-		{"GQE", dp("1975-07-07"), dp("1986-06-02"), true},
-		{"XAF", dp("1993-01-01"), nil, true},
+		{"GQE", Date{1975, 7, 7}, Date{1986, 6, 2}, true},
+		{"XAF", Date{1993, 1, 1}, Date{}, true},
 	},
 	"GR": {
-		{"GRD", dp("1954-05-01"), dp("2002-03-01"), true},
-		{"EUR", dp("2001-01-01"), nil, true},
+		{"GRD", Date{1954, 5, 1}, Date{2002, 3, 1}, true},
+		{"EUR", Date{2001, 1, 1}, Date{}, true},
 	},
 	"GS": {
-		{"GBP", dp("1908-01-01"), nil, true},
+		{"GBP", Date{1908, 1, 1}, Date{}, true},
 	},
 	"GT": {
-		{"GTQ", dp("1925-05-27"), nil, true},
+		{"GTQ", Date{1925, 5, 27}, Date{}, true},
 	},
 	"GU": {
-		{"USD", dp("1944-08-21"), nil, true},
+		{"USD", Date{1944, 8, 21}, Date{}, true},
 	},
 	"GW": {
-		{"GWE", dp("1914-01-01"), dp("1976-02-29"), true},
-		{"GWP", dp("1976-02-28"), dp("1997-04-01"), true},
-		{"XOF", dp("1997-03-31"), nil, true},
+		{"GWE", Date{1914, 1, 1}, Date{1976, 2, 29}, true},
+		{"GWP", Date{1976, 2, 28}, Date{1997, 4, 1}, true},
+		{"XOF", Date{1997, 3, 31}, Date{}, true},
 	},
 	"GY": {
-		{"GYD", dp("1966-05-26"), nil, true},
+		{"GYD", Date{1966, 5, 26}, Date{}, true},
 	},
 	"HK": {
-		{"HKD", dp("1895-02-02"), nil, true},
+		{"HKD", Date{1895, 2, 2}, Date{}, true},
 	},
 	"HM": {
-		{"AUD", dp("1967-02-16"), nil, true},
+		{"AUD", Date{1967, 2, 16}, Date{}, true},
 	},
 	"HN": {
-		{"HNL", dp("1926-04-03"), nil, true},
+		{"HNL", Date{1926, 4, 3}, Date{}, true},
 	},
 	"HR": {
-		{"YUD", dp("1966-01-01"), dp("1990-01-02"), true},
-		{"YUN", dp("1990-01-01"), dp("1991-12-24"), true},
-		{"HRD", dp("1991-12-23"), dp("1995-01-02"), true},
-		{"HRK", dp("1994-05-30"), nil, true},
+		{"YUD", Date{1966, 1, 1}, Date{1990, 1, 2}, true},
+		{"YUN", Date{1990, 1, 1}, Date{1991, 12, 24}, true},
+		{"HRD", Date{1991, 12, 23}, Date{1995, 1, 2}, true},
+		{"HRK", Date{1994, 5, 30}, Date{}, true},
 	},
 	"HT": {
-		{"HTG", dp("1872-08-26"), nil, true},
-		{"USD", dp("1915-01-01"), nil, true},
+		{"HTG", Date{1872, 8, 26}, Date{}, true},
+		{"USD", Date{1915, 1, 1}, Date{}, true},
 	},
 	"HU": {
-		{"HUF", dp("1946-07-23"), nil, true},
+		{"HUF", Date{1946, 7, 23}, Date{}, true},
 	},
 	"IC": {
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"ID": {
-		{"IDR", dp("1965-12-13"), nil, true},
+		{"IDR", Date{1965, 12, 13}, Date{}, true},
 	},
 	"IE": {
-		{"GBP", dp("1800-01-01"), dp("1922-01-02"), true},
-		{"IEP", dp("1922-01-01"), dp("2002-02-10"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"GBP", Date{1800, 1, 1}, Date{1922, 1, 2}, true},
+		{"IEP", Date{1922, 1, 1}, Date{2002, 2, 10}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"IL": {
-		{"ILP", dp("1948-08-16"), dp("1980-02-23"), true},
-		{"ILR", dp("1980-02-22"), dp("1985-09-05"), true},
-		{"ILS", dp("1985-09-04"), nil, true},
+		{"ILP", Date{1948, 8, 16}, Date{1980, 2, 23}, true},
+		{"ILR", Date{1980, 2, 22}, Date{1985, 9, 5}, true},
+		{"ILS", Date{1985, 9, 4}, Date{}, true},
 	},
 	"IM": {
-		{"GBP", dp("1840-01-03"), nil, true},
+		{"GBP", Date{1840, 1, 3}, Date{}, true},
 	},
 	"IN": {
-		{"INR", dp("1835-08-17"), nil, true},
+		{"INR", Date{1835, 8, 17}, Date{}, true},
 	},
 	"IO": {
-		{"USD", dp("1965-11-08"), nil, true},
+		{"USD", Date{1965, 11, 8}, Date{}, true},
 	},
 	"IQ": {
-		{"EGP", dp("1920-11-11"), dp("1931-04-20"), true},
-		{"INR", dp("1920-11-11"), dp("1931-04-20"), true},
-		{"IQD", dp("1931-04-19"), nil, true},
+		{"EGP", Date{1920, 11, 11}, Date{1931, 4, 20}, true},
+		{"INR", Date{1920, 11, 11}, Date{1931, 4, 20}, true},
+		{"IQD", Date{1931, 4, 19}, Date{}, true},
 	},
 	"IR": {
-		{"IRR", dp("1932-05-13"), nil, true},
+		{"IRR", Date{1932, 5, 13}, Date{}, true},
 	},
 	"IS": {
-		{"DKK", dp("1873-05-27"), dp("1918-12-02"), true},
-		{"ISJ", dp("1918-12-01"), dp("1981-01-02"), true},
-		{"ISK", dp("1981-01-01"), nil, true},
+		{"DKK", Date{1873, 5, 27}, Date{1918, 12, 2}, true},
+		{"ISJ", Date{1918, 12, 1}, Date{1981, 1, 2}, true},
+		{"ISK", Date{1981, 1, 1}, Date{}, true},
 	},
 	"IT": {
-		{"ITL", dp("1862-08-24"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ITL", Date{1862, 8, 24}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"JE": {
-		{"GBP", dp("1837-01-01"), nil, true},
+		{"GBP", Date{1837, 1, 1}, Date{}, true},
 	},
 	"JM": {
-		{"JMD", dp("1969-09-08"), nil, true},
+		{"JMD", Date{1969, 9, 8}, Date{}, true},
 	},
 	"JO": {
-		{"JOD", dp("1950-07-01"), nil, true},
+		{"JOD", Date{1950, 7, 1}, Date{}, true},
 	},
 	"JP": {
-		{"JPY", dp("1871-06-01"), nil, true},
+		{"JPY", Date{1871, 6, 1}, Date{}, true},
 	},
 	"KE": {
-		{"KES", dp("1966-09-14"), nil, true},
+		{"KES", Date{1966, 9, 14}, Date{}, true},
 	},
 	"KG": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1993-05-11"), true},
-		{"KGS", dp("1993-05-10"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1993, 5, 11}, true},
+		{"KGS", Date{1993, 5, 10}, Date{}, true},
 	},
 	"KH": {
-		{"KHR", dp("1980-03-20"), nil, true},
+		{"KHR", Date{1980, 3, 20}, Date{}, true},
 	},
 	"KI": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"KM": {
-		{"KMF", dp("1975-07-06"), nil, true},
+		{"KMF", Date{1975, 7, 6}, Date{}, true},
 	},
 	"KN": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"KP": {
-		{"KPW", dp("1959-04-17"), nil, true},
+		{"KPW", Date{1959, 4, 17}, Date{}, true},
 	},
 	"KR": {
-		{"KRO", dp("1945-08-15"), dp("1953-02-16"), true},
-		{"KRH", dp("1953-02-15"), dp("1962-06-11"), true},
-		{"KRW", dp("1962-06-10"), nil, true},
+		{"KRO", Date{1945, 8, 15}, Date{1953, 2, 16}, true},
+		{"KRH", Date{1953, 2, 15}, Date{1962, 6, 11}, true},
+		{"KRW", Date{1962, 6, 10}, Date{}, true},
 	},
 	"KW": {
-		{"KWD", dp("1961-04-01"), nil, true},
+		{"KWD", Date{1961, 4, 1}, Date{}, true},
 	},
 	"KY": {
-		{"JMD", dp("1969-09-08"), dp("1971-01-02"), true},
-		{"KYD", dp("1971-01-01"), nil, true},
+		{"JMD", Date{1969, 9, 8}, Date{1971, 1, 2}, true},
+		{"KYD", Date{1971, 1, 1}, Date{}, true},
 	},
 	"KZ": {
-		{"KZT", dp("1993-11-05"), nil, true},
+		{"KZT", Date{1993, 11, 5}, Date{}, true},
 	},
 	"LA": {
-		{"LAK", dp("1979-12-10"), nil, true},
+		{"LAK", Date{1979, 12, 10}, Date{}, true},
 	},
 	"LB": {
-		{"LBP", dp("1948-02-02"), nil, true},
+		{"LBP", Date{1948, 2, 2}, Date{}, true},
 	},
 	"LC": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"LI": {
-		{"CHF", dp("1921-02-01"), nil, true},
+		{"CHF", Date{1921, 2, 1}, Date{}, true},
 	},
 	"LK": {
-		{"LKR", dp("1978-05-22"), nil, true},
+		{"LKR", Date{1978, 5, 22}, Date{}, true},
 	},
 	"LR": {
-		{"LRD", dp("1944-01-01"), nil, true},
+		{"LRD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"LS": {
-		{"ZAR", dp("1961-02-14"), nil, true},
-		{"LSL", dp("1980-01-22"), nil, true},
+		{"ZAR", Date{1961, 2, 14}, Date{}, true},
+		{"LSL", Date{1980, 1, 22}, Date{}, true},
 	},
 	"LT": {
-		{"SUR", dp("1961-01-01"), dp("1992-10-02"), true},
-		{"LTT", dp("1992-10-01"), dp("1993-06-26"), true},
-		{"LTL", dp("1993-06-25"), dp("2015-01-01"), true},
-		{"EUR", dp("2015-01-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1992, 10, 2}, true},
+		{"LTT", Date{1992, 10, 1}, Date{1993, 6, 26}, true},
+		{"LTL", Date{1993, 6, 25}, Date{2015, 1, 1}, true},
+		{"EUR", Date{2015, 1, 1}, Date{}, true},
 	},
 	"LU": {
-		{"LUF", dp("1944-09-04"), dp("2002-03-01"), true},
-		{"LUC", dp("1970-01-01"), dp("1990-03-06"), false},
-		{"LUL", dp("1970-01-01"), dp("1990-03-06"), false},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"LUF", Date{1944, 9, 4}, Date{2002, 3, 1}, true},
+		{"LUC", Date{1970, 1, 1}, Date{1990, 3, 6}, false},
+		{"LUL", Date{1970, 1, 1}, Date{1990, 3, 6}, false},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"LV": {
-		{"SUR", dp("1961-01-01"), dp("1992-07-21"), true},
-		{"LVR", dp("1992-05-07"), dp("1993-10-18"), true},
-		{"LVL", dp("1993-06-28"), dp("2014-01-01"), true},
-		{"EUR", dp("2014-01-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1992, 7, 21}, true},
+		{"LVR", Date{1992, 5, 7}, Date{1993, 10, 18}, true},
+		{"LVL", Date{1993, 6, 28}, Date{2014, 1, 1}, true},
+		{"EUR", Date{2014, 1, 1}, Date{}, true},
 	},
 	"LY": {
-		{"LYD", dp("1971-09-01"), nil, true},
+		{"LYD", Date{1971, 9, 1}, Date{}, true},
 	},
 	"MA": {
-		{"MAF", dp("1881-01-01"), dp("1959-10-18"), true},
-		{"MAD", dp("1959-10-17"), nil, true},
+		{"MAF", Date{1881, 1, 1}, Date{1959, 10, 18}, true},
+		{"MAD", Date{1959, 10, 17}, Date{}, true},
 	},
 	"MC": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"MCF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"MCF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"MD": {
-		{"MDC", dp("1992-06-01"), dp("1993-11-30"), true},
-		{"MDL", dp("1993-11-29"), nil, true},
+		{"MDC", Date{1992, 6, 1}, Date{1993, 11, 30}, true},
+		{"MDL", Date{1993, 11, 29}, Date{}, true},
 	},
 	"ME": {
-		{"YUM", dp("1994-01-24"), dp("2002-05-16"), true},
-		{"DEM", dp("1999-10-02"), dp("2002-05-16"), true},
-		{"EUR", dp("2002-01-01"), nil, true},
+		{"YUM", Date{1994, 1, 24}, Date{2002, 5, 16}, true},
+		{"DEM", Date{1999, 10, 2}, Date{2002, 5, 16}, true},
+		{"EUR", Date{2002, 1, 1}, Date{}, true},
 	},
 	"MF": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"MG": {
-		{"MGF", dp("1963-07-01"), dp("2005-01-01"), true},
-		{"MGA", dp("1983-11-01"), nil, true},
+		{"MGF", Date{1963, 7, 1}, Date{2005, 1, 1}, true},
+		{"MGA", Date{1983, 11, 1}, Date{}, true},
 	},
 	"MH": {
-		{"USD", dp("1944-01-01"), nil, true},
+		{"USD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"MK": {
-		{"MKN", dp("1992-04-26"), dp("1993-05-21"), true},
-		{"MKD", dp("1993-05-20"), nil, true},
+		{"MKN", Date{1992, 4, 26}, Date{1993, 5, 21}, true},
+		{"MKD", Date{1993, 5, 20}, Date{}, true},
 	},
 	"ML": {
-		{"XOF", dp("1958-11-24"), dp("1962-07-03"), true},
-		{"MLF", dp("1962-07-02"), dp("1984-09-01"), true},
-		{"XOF", dp("1984-06-01"), nil, true},
+		{"XOF", Date{1958, 11, 24}, Date{1962, 7, 3}, true},
+		{"MLF", Date{1962, 7, 2}, Date{1984, 9, 1}, true},
+		{"XOF", Date{1984, 6, 1}, Date{}, true},
 	},
 	"MM": {
-		{"BUK", dp("1952-07-01"), dp("1989-06-19"), true},
-		{"MMK", dp("1989-06-18"), nil, true},
+		{"BUK", Date{1952, 7, 1}, Date{1989, 6, 19}, true},
+		{"MMK", Date{1989, 6, 18}, Date{}, true},
 	},
 	"MN": {
-		{"MNT", dp("1915-03-01"), nil, true},
+		{"MNT", Date{1915, 3, 1}, Date{}, true},
 	},
 	"MO": {
-		{"MOP", dp("1901-01-01"), nil, true},
+		{"MOP", Date{1901, 1, 1}, Date{}, true},
 	},
 	"MP": {
-		{"USD", dp("1944-01-01"), nil, true},
+		{"USD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"MQ": {
-		{"FRF", dp("1960-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1960, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"MR": {
-		{"XOF", dp("1958-11-28"), dp("1973-06-30"), true},
-		{"MRO", dp("1973-06-29"), dp("2018-07-01"), true},
-		{"MRU", dp("2018-01-01"), nil, true},
+		{"XOF", Date{1958, 11, 28}, Date{1973, 6, 30}, true},
+		{"MRO", Date{1973, 6, 29}, Date{2018, 7, 1}, true},
+		{"MRU", Date{2018, 1, 1}, Date{}, true},
 	},
 	"MS": {
-		{"XCD", dp("1967-02-27"), nil, true},
+		{"XCD", Date{1967, 2, 27}, Date{}, true},
 	},
 	"MT": {
-		{"MTP", dp("1914-08-13"), dp("1968-06-08"), true},
-		{"MTL", dp("1968-06-07"), dp("2008-02-01"), true},
-		{"EUR", dp("2008-01-01"), nil, true},
+		{"MTP", Date{1914, 8, 13}, Date{1968, 6, 8}, true},
+		{"MTL", Date{1968, 6, 7}, Date{2008, 2, 1}, true},
+		{"EUR", Date{2008, 1, 1}, Date{}, true},
 	},
 	"MU": {
-		{"MUR", dp("1934-04-01"), nil, true},
+		{"MUR", Date{1934, 4, 1}, Date{}, true},
 	},
 	"MV": {
-		{"MVP", dp("1947-01-01"), dp("1981-07-02"), true},
-		{"MVR", dp("1981-07-01"), nil, true},
+		{"MVP", Date{1947, 1, 1}, Date{1981, 7, 2}, true},
+		{"MVR", Date{1981, 7, 1}, Date{}, true},
 	},
 	"MW": {
-		{"MWK", dp("1971-02-15"), nil, true},
+		{"MWK", Date{1971, 2, 15}, Date{}, true},
 	},
 	"MX": {
-		{"MXV", nil, nil, false},
-		{"MXP", dp("1822-01-01"), dp("1993-01-01"), true},
-		{"MXN", dp("1993-01-01"), nil, true},
+		{"MXV", Date{}, Date{}, false},
+		{"MXP", Date{1822, 1, 1}, Date{1993, 1, 1}, true},
+		{"MXN", Date{1993, 1, 1}, Date{}, true},
 	},
 	"MY": {
-		{"MYR", dp("1963-09-16"), nil, true},
+		{"MYR", Date{1963, 9, 16}, Date{}, true},
 	},
 	"MZ": {
-		{"MZE", dp("1975-06-25"), dp("1980-06-17"), true},
-		{"MZM", dp("1980-06-16"), dp("2007-01-01"), true},
-		{"MZN", dp("2006-07-01"), nil, true},
+		{"MZE", Date{1975, 6, 25}, Date{1980, 6, 17}, true},
+		{"MZM", Date{1980, 6, 16}, Date{2007, 1, 1}, true},
+		{"MZN", Date{2006, 7, 1}, Date{}, true},
 	},
 	"NA": {
-		{"ZAR", dp("1961-02-14"), nil, true},
-		{"NAD", dp("1993-01-01"), nil, true},
+		{"ZAR", Date{1961, 2, 14}, Date{}, true},
+		{"NAD", Date{1993, 1, 1}, Date{}, true},
 	},
 	"NC": {
-		{"XPF", dp("1985-01-01"), nil, true},
+		{"XPF", Date{1985, 1, 1}, Date{}, true},
 	},
 	"NE": {
-		{"XOF", dp("1958-12-19"), nil, true},
+		{"XOF", Date{1958, 12, 19}, Date{}, true},
 	},
 	"NF": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"NG": {
-		{"NGN", dp("1973-01-01"), nil, true},
+		{"NGN", Date{1973, 1, 1}, Date{}, true},
 	},
 	"NI": {
-		{"NIC", dp("1988-02-15"), dp("1991-05-01"), true},
-		{"NIO", dp("1991-04-30"), nil, true},
+		{"NIC", Date{1988, 2, 15}, Date{1991, 5, 1}, true},
+		{"NIO", Date{1991, 4, 30}, Date{}, true},
 	},
 	"NL": {
-		{"NLG", dp("1813-01-01"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"NLG", Date{1813, 1, 1}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"NO": {
-		{"SEK", dp("1873-05-27"), dp("1905-06-08"), true},
-		{"NOK", dp("1905-06-07"), nil, true},
+		{"SEK", Date{1873, 5, 27}, Date{1905, 6, 8}, true},
+		{"NOK", Date{1905, 6, 7}, Date{}, true},
 	},
 	"NP": {
-		{"INR", dp("1870-01-01"), dp("1966-10-18"), true},
-		{"NPR", dp("1933-01-01"), nil, true},
+		{"INR", Date{1870, 1, 1}, Date{1966, 10, 18}, true},
+		{"NPR", Date{1933, 1, 1}, Date{}, true},
 	},
 	"NR": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"NU": {
-		{"NZD", dp("1967-07-10"), nil, true},
+		{"NZD", Date{1967, 7, 10}, Date{}, true},
 	},
 	"NZ": {
-		{"NZD", dp("1967-07-10"), nil, true},
+		{"NZD", Date{1967, 7, 10}, Date{}, true},
 	},
 	"OM": {
-		{"OMR", dp("1972-11-11"), nil, true},
+		{"OMR", Date{1972, 11, 11}, Date{}, true},
 	},
 	"PA": {
-		{"PAB", dp("1903-11-04"), nil, true},
-		{"USD", dp("1903-11-18"), nil, true},
+		{"PAB", Date{1903, 11, 4}, Date{}, true},
+		{"USD", Date{1903, 11, 18}, Date{}, true},
 	},
 	"PE": {
-		{"PES", dp("1863-02-14"), dp("1985-02-02"), true},
-		{"PEI", dp("1985-02-01"), dp("1991-07-02"), true},
-		{"PEN", dp("1991-07-01"), nil, true},
+		{"PES", Date{1863, 2, 14}, Date{1985, 2, 2}, true},
+		{"PEI", Date{1985, 2, 1}, Date{1991, 7, 2}, true},
+		{"PEN", Date{1991, 7, 1}, Date{}, true},
 	},
 	"PF": {
-		{"XPF", dp("1945-12-26"), nil, true},
+		{"XPF", Date{1945, 12, 26}, Date{}, true},
 	},
 	"PG": {
-		{"AUD", dp("1966-02-14"), dp("1975-09-17"), true},
-		{"PGK", dp("1975-09-16"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{1975, 9, 17}, true},
+		{"PGK", Date{1975, 9, 16}, Date{}, true},
 	},
 	"PH": {
-		{"PHP", dp("1946-07-04"), nil, true},
+		{"PHP", Date{1946, 7, 4}, Date{}, true},
 	},
 	"PK": {
-		{"INR", dp("1835-08-17"), dp("1947-08-16"), true},
-		{"PKR", dp("1948-04-01"), nil, true},
+		{"INR", Date{1835, 8, 17}, Date{1947, 8, 16}, true},
+		{"PKR", Date{1948, 4, 1}, Date{}, true},
 	},
 	"PL": {
-		{"PLZ", dp("1950-10-28"), dp("1995-01-01"), true},
-		{"PLN", dp("1995-01-01"), nil, true},
+		{"PLZ", Date{1950, 10, 28}, Date{1995, 1, 1}, true},
+		{"PLN", Date{1995, 1, 1}, Date{}, true},
 	},
 	"PM": {
-		{"FRF", dp("1972-12-21"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1972, 12, 21}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"PN": {
-		{"NZD", dp("1969-01-13"), nil, true},
+		{"NZD", Date{1969, 1, 13}, Date{}, true},
 	},
 	"PR": {
-		{"ESP", dp("1800-01-01"), dp("1898-12-11"), true},
-		{"USD", dp("1898-12-10"), nil, true},
+		{"ESP", Date{1800, 1, 1}, Date{1898, 12, 11}, true},
+		{"USD", Date{1898, 12, 10}, Date{}, true},
 	},
 	"PS": {
-		{"JOD", dp("1950-07-01"), dp("1967-06-02"), true},
-		{"ILP", dp("1967-06-01"), dp("1980-02-23"), true},
-		{"ILS", dp("1985-09-04"), nil, true},
-		{"JOD", dp("1996-02-12"), nil, true},
+		{"JOD", Date{1950, 7, 1}, Date{1967, 6, 2}, true},
+		{"ILP", Date{1967, 6, 1}, Date{1980, 2, 23}, true},
+		{"ILS", Date{1985, 9, 4}, Date{}, true},
+		{"JOD", Date{1996, 2, 12}, Date{}, true},
 	},
 	"PT": {
-		{"PTE", dp("1911-05-22"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"PTE", Date{1911, 5, 22}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"PW": {
-		{"USD", dp("1944-01-01"), nil, true},
+		{"USD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"PY": {
-		{"PYG", dp("1943-11-01"), nil, true},
+		{"PYG", Date{1943, 11, 1}, Date{}, true},
 	},
 	"QA": {
-		{"QAR", dp("1973-05-19"), nil, true},
+		{"QAR", Date{1973, 5, 19}, Date{}, true},
 	},
 	"RE": {
-		{"FRF", dp("1975-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1975, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"RO": {
-		{"ROL", dp("1952-01-28"), dp("2007-01-01"), true},
-		{"RON", dp("2005-07-01"), nil, true},
+		{"ROL", Date{1952, 1, 28}, Date{2007, 1, 1}, true},
+		{"RON", Date{2005, 7, 1}, Date{}, true},
 	},
 	"RS": {
-		{"YUM", dp("1994-01-24"), dp("2002-05-16"), true},
-		{"CSD", dp("2002-05-15"), dp("2006-10-26"), true},
-		{"RSD", dp("2006-10-25"), nil, true},
+		{"YUM", Date{1994, 1, 24}, Date{2002, 5, 16}, true},
+		{"CSD", Date{2002, 5, 15}, Date{2006, 10, 26}, true},
+		{"RSD", Date{2006, 10, 25}, Date{}, true},
 	},
 	"RU": {
-		{"RUR", dp("1991-12-25"), dp("1999-01-01"), true},
-		{"RUB", dp("1999-01-01"), nil, true},
+		{"RUR", Date{1991, 12, 25}, Date{1999, 1, 1}, true},
+		{"RUB", Date{1999, 1, 1}, Date{}, true},
 	},
 	"RW": {
-		{"RWF", dp("1964-05-19"), nil, true},
+		{"RWF", Date{1964, 5, 19}, Date{}, true},
 	},
 	"SA": {
-		{"SAR", dp("1952-10-22"), nil, true},
+		{"SAR", Date{1952, 10, 22}, Date{}, true},
 	},
 	"SB": {
-		{"AUD", dp("1966-02-14"), dp("1978-07-01"), true},
-		{"SBD", dp("1977-10-24"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{1978, 7, 1}, true},
+		{"SBD", Date{1977, 10, 24}, Date{}, true},
 	},
 	"SC": {
-		{"SCR", dp("1903-11-01"), nil, true},
+		{"SCR", Date{1903, 11, 1}, Date{}, true},
 	},
 	"SD": {
-		{"EGP", dp("1889-01-19"), dp("1958-01-02"), true},
-		{"GBP", dp("1889-01-19"), dp("1958-01-02"), true},
-		{"SDP", dp("1957-04-08"), dp("1998-06-02"), true},
-		{"SDD", dp("1992-06-08"), dp("2007-07-01"), true},
-		{"SDG", dp("2007-01-10"), nil, true},
+		{"EGP", Date{1889, 1, 19}, Date{1958, 1, 2}, true},
+		{"GBP", Date{1889, 1, 19}, Date{1958, 1, 2}, true},
+		{"SDP", Date{1957, 4, 8}, Date{1998, 6, 2}, true},
+		{"SDD", Date{1992, 6, 8}, Date{2007, 7, 1}, true},
+		{"SDG", Date{2007, 1, 10}, Date{}, true},
 	},
 	"SE": {
-		{"SEK", dp("1873-05-27"), nil, true},
+		{"SEK", Date{1873, 5, 27}, Date{}, true},
 	},
 	"SG": {
-		{"MYR", dp("1963-09-16"), dp("1967-06-13"), true},
-		{"SGD", dp("1967-06-12"), nil, true},
+		{"MYR", Date{1963, 9, 16}, Date{1967, 6, 13}, true},
+		{"SGD", Date{1967, 6, 12}, Date{}, true},
 	},
 	"SH": {
-		{"SHP", dp("1917-02-15"), nil, true},
+		{"SHP", Date{1917, 2, 15}, Date{}, true},
 	},
 	"SI": {
-		{"SIT", dp("1992-10-07"), dp("2007-01-15"), true},
-		{"EUR", dp("2007-01-01"), nil, true},
+		{"SIT", Date{1992, 10, 7}, Date{2007, 1, 15}, true},
+		{"EUR", Date{2007, 1, 1}, Date{}, true},
 	},
 	"SJ": {
-		{"NOK", dp("1905-06-07"), nil, true},
+		{"NOK", Date{1905, 6, 7}, Date{}, true},
 	},
 	"SK": {
-		{"CSK", dp("1953-06-01"), dp("1993-01-01"), true},
-		{"SKK", dp("1992-12-31"), dp("2009-01-02"), true},
-		{"EUR", dp("2009-01-01"), nil, true},
+		{"CSK", Date{1953, 6, 1}, Date{1993, 1, 1}, true},
+		{"SKK", Date{1992, 12, 31}, Date{2009, 1, 2}, true},
+		{"EUR", Date{2009, 1, 1}, Date{}, true},
 	},
 	"SL": {
-		{"GBP", dp("1808-11-30"), dp("1966-02-05"), true},
-		{"SLL", dp("1964-08-04"), nil, true},
+		{"GBP", Date{1808, 11, 30}, Date{1966, 2, 5}, true},
+		{"SLL", Date{1964, 8, 4}, Date{}, true},
 	},
 	"SM": {
-		{"ITL", dp("1865-12-23"), dp("2001-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ITL", Date{1865, 12, 23}, Date{2001, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"SN": {
-		{"XOF", dp("1959-04-04"), nil, true},
+		{"XOF", Date{1959, 4, 4}, Date{}, true},
 	},
 	"SO": {
-		{"SOS", dp("1960-07-01"), nil, true},
+		{"SOS", Date{1960, 7, 1}, Date{}, true},
 	},
 	"SR": {
-		{"NLG", dp("1815-11-20"), dp("1940-05-11"), true},
-		{"SRG", dp("1940-05-10"), dp("2004-01-01"), true},
-		{"SRD", dp("2004-01-01"), nil, true},
+		{"NLG", Date{1815, 11, 20}, Date{1940, 5, 11}, true},
+		{"SRG", Date{1940, 5, 10}, Date{2004, 1, 1}, true},
+		{"SRD", Date{2004, 1, 1}, Date{}, true},
 	},
 	"SS": {
-		{"SDG", dp("2007-01-10"), dp("2011-09-02"), true},
-		{"SSP", dp("2011-07-18"), nil, true},
+		{"SDG", Date{2007, 1, 10}, Date{2011, 9, 2}, true},
+		{"SSP", Date{2011, 7, 18}, Date{}, true},
 	},
 	"ST": {
-		{"STD", dp("1977-09-08"), dp("2018-01-01"), true},
-		{"STN", dp("2018-01-01"), nil, true},
+		{"STD", Date{1977, 9, 8}, Date{2018, 1, 1}, true},
+		{"STN", Date{2018, 1, 1}, Date{}, true},
 	},
 	"SU": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
 	},
 	"SV": {
-		{"SVC", dp("1919-11-11"), dp("2001-01-02"), true},
-		{"USD", dp("2001-01-01"), nil, true},
+		{"SVC", Date{1919, 11, 11}, Date{2001, 1, 2}, true},
+		{"USD", Date{2001, 1, 1}, Date{}, true},
 	},
 	"SX": {
-		{"ANG", dp("2010-10-10"), nil, true},
+		{"ANG", Date{2010, 10, 10}, Date{}, true},
 	},
 	"SY": {
-		{"SYP", dp("1948-01-01"), nil, true},
+		{"SYP", Date{1948, 1, 1}, Date{}, true},
 	},
 	"SZ": {
-		{"SZL", dp("1974-09-06"), nil, true},
+		{"SZL", Date{1974, 9, 6}, Date{}, true},
 	},
 	"TA": {
-		{"GBP", dp("1938-01-12"), nil, true},
+		{"GBP", Date{1938, 1, 12}, Date{}, true},
 	},
 	"TC": {
-		{"USD", dp("1969-09-08"), nil, true},
+		{"USD", Date{1969, 9, 8}, Date{}, true},
 	},
 	"TD": {
-		{"XAF", dp("1993-01-01"), nil, true},
+		{"XAF", Date{1993, 1, 1}, Date{}, true},
 	},
 	"TF": {
-		{"FRF", dp("1959-01-01"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"FRF", Date{1959, 1, 1}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"TG": {
-		{"XOF", dp("1958-11-28"), nil, true},
+		{"XOF", Date{1958, 11, 28}, Date{}, true},
 	},
 	"TH": {
-		{"THB", dp("1928-04-15"), nil, true},
+		{"THB", Date{1928, 4, 15}, Date{}, true},
 	},
 	"TJ": {
-		{"RUR", dp("1991-12-25"), dp("1995-05-11"), true},
-		{"TJR", dp("1995-05-10"), dp("2000-10-26"), true},
-		{"TJS", dp("2000-10-26"), nil, true},
+		{"RUR", Date{1991, 12, 25}, Date{1995, 5, 11}, true},
+		{"TJR", Date{1995, 5, 10}, Date{2000, 10, 26}, true},
+		{"TJS", Date{2000, 10, 26}, Date{}, true},
 	},
 	"TK": {
-		{"NZD", dp("1967-07-10"), nil, true},
+		{"NZD", Date{1967, 7, 10}, Date{}, true},
 	},
 	"TL": {
-		{"TPE", dp("1959-01-02"), dp("2002-05-21"), true},
-		{"IDR", dp("1975-12-07"), dp("2002-05-21"), true},
-		{"USD", dp("1999-10-20"), nil, true},
+		{"TPE", Date{1959, 1, 2}, Date{2002, 5, 21}, true},
+		{"IDR", Date{1975, 12, 7}, Date{2002, 5, 21}, true},
+		{"USD", Date{1999, 10, 20}, Date{}, true},
 	},
 	"TM": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1993-11-02"), true},
-		{"TMM", dp("1993-11-01"), dp("2009-01-02"), true},
-		{"TMT", dp("2009-01-01"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1993, 11, 2}, true},
+		{"TMM", Date{1993, 11, 1}, Date{2009, 1, 2}, true},
+		{"TMT", Date{2009, 1, 1}, Date{}, true},
 	},
 	"TN": {
-		{"TND", dp("1958-11-01"), nil, true},
+		{"TND", Date{1958, 11, 1}, Date{}, true},
 	},
 	"TO": {
-		{"TOP", dp("1966-02-14"), nil, true},
+		{"TOP", Date{1966, 2, 14}, Date{}, true},
 	},
 	"TP": {
-		{"TPE", dp("1959-01-02"), dp("2002-05-21"), true},
-		{"IDR", dp("1975-12-07"), dp("2002-05-21"), true},
+		{"TPE", Date{1959, 1, 2}, Date{2002, 5, 21}, true},
+		{"IDR", Date{1975, 12, 7}, Date{2002, 5, 21}, true},
 	},
 	"TR": {
-		{"TRL", dp("1922-11-01"), dp("2006-01-01"), true},
-		{"TRY", dp("2005-01-01"), nil, true},
+		{"TRL", Date{1922, 11, 1}, Date{2006, 1, 1}, true},
+		{"TRY", Date{2005, 1, 1}, Date{}, true},
 	},
 	"TT": {
-		{"TTD", dp("1964-01-01"), nil, true},
+		{"TTD", Date{1964, 1, 1}, Date{}, true},
 	},
 	"TV": {
-		{"AUD", dp("1966-02-14"), nil, true},
+		{"AUD", Date{1966, 2, 14}, Date{}, true},
 	},
 	"TW": {
-		{"TWD", dp("1949-06-15"), nil, true},
+		{"TWD", Date{1949, 6, 15}, Date{}, true},
 	},
 	"TZ": {
-		{"TZS", dp("1966-06-14"), nil, true},
+		{"TZS", Date{1966, 6, 14}, Date{}, true},
 	},
 	"UA": {
-		{"SUR", dp("1961-01-01"), dp("1991-12-26"), true},
-		{"RUR", dp("1991-12-25"), dp("1992-11-14"), true},
-		{"UAK", dp("1992-11-13"), dp("1993-10-18"), true},
-		{"UAH", dp("1996-09-02"), nil, true},
+		{"SUR", Date{1961, 1, 1}, Date{1991, 12, 26}, true},
+		{"RUR", Date{1991, 12, 25}, Date{1992, 11, 14}, true},
+		{"UAK", Date{1992, 11, 13}, Date{1993, 10, 18}, true},
+		{"UAH", Date{1996, 9, 2}, Date{}, true},
 	},
 	"UG": {
-		{"UGS", dp("1966-08-15"), dp("1987-05-16"), true},
-		{"UGX", dp("1987-05-15"), nil, true},
+		{"UGS", Date{1966, 8, 15}, Date{1987, 5, 16}, true},
+		{"UGX", Date{1987, 5, 15}, Date{}, true},
 	},
 	"UM": {
-		{"USD", dp("1944-01-01"), nil, true},
+		{"USD", Date{1944, 1, 1}, Date{}, true},
 	},
 	"US": {
-		{"USN", nil, nil, false},
-		{"USS", nil, dp("2014-03-02"), false},
-		{"USD", dp("1792-01-01"), nil, true},
+		{"USN", Date{}, Date{}, false},
+		{"USS", Date{}, Date{2014, 3, 2}, false},
+		{"USD", Date{1792, 1, 1}, Date{}, true},
 	},
 	"UY": {
-		{"UYI", nil, nil, false},
-		{"UYW", nil, nil, false},
-		{"UYP", dp("1975-07-01"), dp("1993-03-02"), true},
-		{"UYU", dp("1993-03-01"), nil, true},
+		{"UYI", Date{}, Date{}, false},
+		{"UYW", Date{}, Date{}, false},
+		{"UYP", Date{1975, 7, 1}, Date{1993, 3, 2}, true},
+		{"UYU", Date{1993, 3, 1}, Date{}, true},
 	},
 	"UZ": {
-		{"UZS", dp("1994-07-01"), nil, true},
+		{"UZS", Date{1994, 7, 1}, Date{}, true},
 	},
 	"VA": {
-		{"ITL", dp("1870-10-19"), dp("2002-03-01"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"ITL", Date{1870, 10, 19}, Date{2002, 3, 1}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"VC": {
-		{"XCD", dp("1965-10-06"), nil, true},
+		{"XCD", Date{1965, 10, 6}, Date{}, true},
 	},
 	"VE": {
-		{"VEB", dp("1871-05-11"), dp("2008-07-01"), true},
-		{"VEF", dp("2008-01-01"), dp("2018-08-21"), true},
-		{"VES", dp("2018-08-20"), nil, true},
+		{"VEB", Date{1871, 5, 11}, Date{2008, 7, 1}, true},
+		{"VEF", Date{2008, 1, 1}, Date{2018, 8, 21}, true},
+		{"VES", Date{2018, 8, 20}, Date{}, true},
 	},
 	"VG": {
-		{"USD", dp("1833-01-01"), nil, true},
-		{"GBP", dp("1833-01-01"), dp("1959-01-02"), true},
+		{"USD", Date{1833, 1, 1}, Date{}, true},
+		{"GBP", Date{1833, 1, 1}, Date{1959, 1, 2}, true},
 	},
 	"VI": {
-		{"USD", dp("1837-01-01"), nil, true},
+		{"USD", Date{1837, 1, 1}, Date{}, true},
 	},
 	"VN": {
-		{"VNN", dp("1978-05-03"), dp("1985-09-15"), true},
-		{"VND", dp("1985-09-14"), nil, true},
+		{"VNN", Date{1978, 5, 3}, Date{1985, 9, 15}, true},
+		{"VND", Date{1985, 9, 14}, Date{}, true},
 	},
 	"VU": {
-		{"VUV", dp("1981-01-01"), nil, true},
+		{"VUV", Date{1981, 1, 1}, Date{}, true},
 	},
 	"WF": {
-		{"XPF", dp("1961-07-30"), nil, true},
+		{"XPF", Date{1961, 7, 30}, Date{}, true},
 	},
 	"WS": {
-		{"WST", dp("1967-07-10"), nil, true},
+		{"WST", Date{1967, 7, 10}, Date{}, true},
 	},
 	"XK": {
-		{"YUM", dp("1994-01-24"), dp("1999-10-01"), true},
-		{"DEM", dp("1999-09-01"), dp("2002-03-10"), true},
-		{"EUR", dp("2002-01-01"), nil, true},
+		{"YUM", Date{1994, 1, 24}, Date{1999, 10, 1}, true},
+		{"DEM", Date{1999, 9, 1}, Date{2002, 3, 10}, true},
+		{"EUR", Date{2002, 1, 1}, Date{}, true},
 	},
 	"YD": {
-		{"YDD", dp("1965-04-01"), dp("1996-01-02"), true},
+		{"YDD", Date{1965, 4, 1}, Date{1996, 1, 2}, true},
 	},
 	"YE": {
-		{"YER", dp("1990-05-22"), nil, true},
+		{"YER", Date{1990, 5, 22}, Date{}, true},
 	},
 	"YT": {
-		{"KMF", dp("1975-01-01"), dp("1976-02-24"), true},
-		{"FRF", dp("1976-02-23"), dp("2002-02-18"), true},
-		{"EUR", dp("1999-01-01"), nil, true},
+		{"KMF", Date{1975, 1, 1}, Date{1976, 2, 24}, true},
+		{"FRF", Date{1976, 2, 23}, Date{2002, 2, 18}, true},
+		{"EUR", Date{1999, 1, 1}, Date{}, true},
 	},
 	"YU": {
-		{"YUD", dp("1966-01-01"), dp("1990-01-02"), true},
-		{"YUN", dp("1990-01-01"), dp("1992-07-25"), true},
-		{"YUM", dp("1994-01-24"), dp("2002-05-16"), true},
+		{"YUD", Date{1966, 1, 1}, Date{1990, 1, 2}, true},
+		{"YUN", Date{1990, 1, 1}, Date{1992, 7, 25}, true},
+		{"YUM", Date{1994, 1, 24}, Date{2002, 5, 16}, true},
 	},
 	"ZA": {
-		{"ZAR", dp("1961-02-14"), nil, true},
-		{"ZAL", dp("1985-09-01"), dp("1995-03-14"), false},
+		{"ZAR", Date{1961, 2, 14}, Date{}, true},
+		{"ZAL", Date{1985, 9, 1}, Date{1995, 3, 14}, false},
 	},
 	"ZM": {
-		{"ZMK", dp("1968-01-16"), dp("2013-01-02"), true},
-		{"ZMW", dp("2013-01-01"), nil, true},
+		{"ZMK", Date{1968, 1, 16}, Date{2013, 1, 2}, true},
+		{"ZMW", Date{2013, 1, 1}, Date{}, true},
 	},
 	"ZR": {
-		{"ZRZ", dp("1971-10-27"), dp("1993-11-02"), true},
-		{"ZRN", dp("1993-11-01"), dp("1998-08-01"), true},
+		{"ZRZ", Date{1971, 10, 27}, Date{1993, 11, 2}, true},
+		{"ZRN", Date{1993, 11, 1}, Date{1998, 8, 1}, true},
 	},
 	"ZW": {
-		{"RHD", dp("1970-02-17"), dp("1980-04-19"), true},
-		{"ZWD", dp("1980-04-18"), dp("2008-08-02"), true},
-		{"ZWR", dp("2008-08-01"), dp("2009-02-03"), true},
-		{"ZWL", dp("2009-02-02"), dp("2009-04-13"), true},
-		{"USD", dp("2009-04-12"), nil, true},
+		{"RHD", Date{1970, 2, 17}, Date{1980, 4, 19}, true},
+		{"ZWD", Date{1980, 4, 18}, Date{2008, 8, 2}, true},
+		{"ZWR", Date{2008, 8, 1}, Date{2009, 2, 3}, true},
+		{"ZWL", Date{2009, 2, 2}, Date{2009, 4, 13}, true},
+		{"USD", Date{2009, 4, 12}, Date{}, true},
 	},
 	"ZZ": {
-		{"XAG", nil, nil, false},
-		{"XAU", nil, nil, false},
-		{"XBA", nil, nil, false},
-		{"XBB", nil, nil, false},
-		{"XBC", nil, nil, false},
-		{"XBD", nil, nil, false},
-		{"XDR", nil, nil, false},
-		{"XPD", nil, nil, false},
-		{"XPT", nil, nil, false},
-		{"XSU", nil, nil, false},
-		{"XTS", nil, nil, false},
-		{"XUA", nil, nil, false},
-		{"XXX", nil, nil, false},
-		{"XRE", nil, dp("1999-12-01"), false},
-		{"XFU", nil, dp("2013-12-01"), false},
-		{"XFO", dp("1930-01-01"), dp("2003-04-02"), false},
+		{"XAG", Date{}, Date{}, false},
+		{"XAU", Date{}, Date{}, false},
+		{"XBA", Date{}, Date{}, false},
+		{"XBB", Date{}, Date{}, false},
+		{"XBC", Date{}, Date{}, false},
+		{"XBD", Date{}, Date{}, false},
+		{"XDR", Date{}, Date{}, false},
+		{"XPD", Date{}, Date{}, false},
+		{"XPT", Date{}, Date{}, false},
+		{"XSU", Date{}, Date{}, false},
+		{"XTS", Date{}, Date{}, false},
+		{"XUA", Date{}, Date{}, false},
+		{"XXX", Date{}, Date{}, false},
+		{"XRE", Date{}, Date{1999, 12, 1}, false},
+		{"XFU", Date{}, Date{2013, 12, 1}, false},
+		{"XFO", Date{1930, 1, 1}, Date{2003, 4, 2}, false},
 	},
 }
@@ -0,0 +1,30 @@
+package de
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "de",
+		Currencies:      DE,
+		Symbols:         cldr.NumberSymbols{Decimal: ",", Group: ".", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "#,##0.00\u00a0¤", Accounting: "#,##0.00\u00a0¤"},
+		PluralRules:     cldr.PluralRules{One: "i = 1 and v = 0"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var DE = map[string]cldr.Localization{
+	"AUD": {DisplayName: "Australischer Dollar", DisplayNameCountOne: "Australischer Dollar", DisplayNameCountOther: "Australische Dollar", Symbol: "AU$", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "Brasilianischer Real", DisplayNameCountOne: "Brasilianischer Real", DisplayNameCountOther: "Brasilianische Real", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "Kanadischer Dollar", DisplayNameCountOne: "Kanadischer Dollar", DisplayNameCountOther: "Kanadische Dollar", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "Schweizer Franken", DisplayNameCountOne: "Schweizer Franken", DisplayNameCountOther: "Schweizer Franken", Symbol: "CHF"},
+	"CNY": {DisplayName: "Renminbi Yuan", DisplayNameCountOne: "Chinesischer Yuan", DisplayNameCountOther: "Renminbi Yuan", Symbol: "CN¥", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "Euro", DisplayNameCountOne: "Euro", DisplayNameCountOther: "Euro", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "Britisches Pfund", DisplayNameCountOne: "Britisches Pfund", DisplayNameCountOther: "Britische Pfund", Symbol: "£", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "Indische Rupie", DisplayNameCountOne: "Indische Rupie", DisplayNameCountOther: "Indische Rupien", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "Japanischer Yen", DisplayNameCountOne: "Japanischer Yen", DisplayNameCountOther: "Japanische Yen", Symbol: "¥", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "Mexikanischer Peso", DisplayNameCountOne: "Mexikanischer Peso", DisplayNameCountOther: "Mexikanische Pesos", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "Polnischer Złoty", DisplayNameCountOne: "Polnischer Złoty", DisplayNameCountOther: "Polnische Złoty", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "Russischer Rubel", DisplayNameCountOne: "Russischer Rubel", DisplayNameCountOther: "Russische Rubel", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "US-Dollar", DisplayNameCountOne: "US-Dollar", DisplayNameCountOther: "US-Dollar", Symbol: "$", SymbolAltNarrow: "$"},
+}
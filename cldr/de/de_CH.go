@@ -0,0 +1,15 @@
+package de
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "de_CH",
+		Currencies:      DECH,
+		Symbols:         cldr.NumberSymbols{Decimal: ".", Group: "’", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "¤\u00a0#,##0.00;¤-#,##0.00", Accounting: "¤\u00a0#,##0.00;¤-#,##0.00"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var DECH = map[string]cldr.Localization{}
@@ -0,0 +1,3 @@
+// Package de contains CLDR data for German locales. Importing it registers
+// those locales with the cldr package.
+package de
@@ -1,308 +1,316 @@
 package cldr
 
+var enLocale = &Locale{
+	ID:              "en",
+	Currencies:      EN,
+	Symbols:         NumberSymbols{Decimal: ".", Group: ",", PlusSign: "+", MinusSign: "-"},
+	CurrencyFormats: CurrencyFormats{Standard: "¤#,##0.00", Accounting: "¤#,##0.00;(¤#,##0.00)"},
+	PluralRules:     PluralRules{One: "i = 1 and v = 0"},
+}
+
 // Generated from CLDR version 35
 var EN = map[string]Localization{
-	"ADP": {"Andorran Peseta", "Andorran peseta", "Andorran pesetas", "ADP", "", ""},
-	"AED": {"United Arab Emirates Dirham", "UAE dirham", "UAE dirhams", "AED", "", ""},
-	"AFA": {"Afghan Afghani (1927–2002)", "Afghan afghani (1927–2002)", "Afghan afghanis (1927–2002)", "AFA", "", ""},
-	"AFN": {"Afghan Afghani", "Afghan Afghani", "Afghan Afghanis", "AFN", "", ""},
-	"ALK": {"Albanian Lek (1946–1965)", "Albanian lek (1946–1965)", "Albanian lekë (1946–1965)", "ALK", "", ""},
-	"ALL": {"Albanian Lek", "Albanian lek", "Albanian lekë", "ALL", "", ""},
-	"AMD": {"Armenian Dram", "Armenian dram", "Armenian drams", "AMD", "", ""},
-	"ANG": {"Netherlands Antillean Guilder", "Netherlands Antillean guilder", "Netherlands Antillean guilders", "ANG", "", ""},
-	"AOA": {"Angolan Kwanza", "Angolan kwanza", "Angolan kwanzas", "AOA", "Kz", ""},
-	"AOK": {"Angolan Kwanza (1977–1991)", "Angolan kwanza (1977–1991)", "Angolan kwanzas (1977–1991)", "AOK", "", ""},
-	"AON": {"Angolan New Kwanza (1990–2000)", "Angolan new kwanza (1990–2000)", "Angolan new kwanzas (1990–2000)", "AON", "", ""},
-	"AOR": {"Angolan Readjusted Kwanza (1995–1999)", "Angolan readjusted kwanza (1995–1999)", "Angolan readjusted kwanzas (1995–1999)", "AOR", "", ""},
-	"ARA": {"Argentine Austral", "Argentine austral", "Argentine australs", "ARA", "", ""},
-	"ARL": {"Argentine Peso Ley (1970–1983)", "Argentine peso ley (1970–1983)", "Argentine pesos ley (1970–1983)", "ARL", "", ""},
-	"ARM": {"Argentine Peso (1881–1970)", "Argentine peso (1881–1970)", "Argentine pesos (1881–1970)", "ARM", "", ""},
-	"ARP": {"Argentine Peso (1983–1985)", "Argentine peso (1983–1985)", "Argentine pesos (1983–1985)", "ARP", "", ""},
-	"ARS": {"Argentine Peso", "Argentine peso", "Argentine pesos", "ARS", "$", ""},
-	"ATS": {"Austrian Schilling", "Austrian schilling", "Austrian schillings", "ATS", "", ""},
-	"AUD": {"Australian Dollar", "Australian dollar", "Australian dollars", "A$", "$", ""},
-	"AWG": {"Aruban Florin", "Aruban florin", "Aruban florin", "AWG", "", ""},
-	"AZM": {"Azerbaijani Manat (1993–2006)", "Azerbaijani manat (1993–2006)", "Azerbaijani manats (1993–2006)", "AZM", "", ""},
-	"AZN": {"Azerbaijani Manat", "Azerbaijani manat", "Azerbaijani manats", "AZN", "", ""},
-	"BAD": {"Bosnia-Herzegovina Dinar (1992–1994)", "Bosnia-Herzegovina dinar (1992–1994)", "Bosnia-Herzegovina dinars (1992–1994)", "BAD", "", ""},
-	"BAM": {"Bosnia-Herzegovina Convertible Mark", "Bosnia-Herzegovina convertible mark", "Bosnia-Herzegovina convertible marks", "BAM", "KM", ""},
-	"BAN": {"Bosnia-Herzegovina New Dinar (1994–1997)", "Bosnia-Herzegovina new dinar (1994–1997)", "Bosnia-Herzegovina new dinars (1994–1997)", "BAN", "", ""},
-	"BBD": {"Barbadian Dollar", "Barbadian dollar", "Barbadian dollars", "BBD", "$", ""},
-	"BDT": {"Bangladeshi Taka", "Bangladeshi taka", "Bangladeshi takas", "BDT", "৳", ""},
-	"BEC": {"Belgian Franc (convertible)", "Belgian franc (convertible)", "Belgian francs (convertible)", "BEC", "", ""},
-	"BEF": {"Belgian Franc", "Belgian franc", "Belgian francs", "BEF", "", ""},
-	"BEL": {"Belgian Franc (financial)", "Belgian franc (financial)", "Belgian francs (financial)", "BEL", "", ""},
-	"BGL": {"Bulgarian Hard Lev", "Bulgarian hard lev", "Bulgarian hard leva", "BGL", "", ""},
-	"BGM": {"Bulgarian Socialist Lev", "Bulgarian socialist lev", "Bulgarian socialist leva", "BGM", "", ""},
-	"BGN": {"Bulgarian Lev", "Bulgarian lev", "Bulgarian leva", "BGN", "", ""},
-	"BGO": {"Bulgarian Lev (1879–1952)", "Bulgarian lev (1879–1952)", "Bulgarian leva (1879–1952)", "BGO", "", ""},
-	"BHD": {"Bahraini Dinar", "Bahraini dinar", "Bahraini dinars", "BHD", "", ""},
-	"BIF": {"Burundian Franc", "Burundian franc", "Burundian francs", "BIF", "", ""},
-	"BMD": {"Bermudan Dollar", "Bermudan dollar", "Bermudan dollars", "BMD", "$", ""},
-	"BND": {"Brunei Dollar", "Brunei dollar", "Brunei dollars", "BND", "$", ""},
-	"BOB": {"Bolivian Boliviano", "Bolivian boliviano", "Bolivian bolivianos", "BOB", "Bs", ""},
-	"BOL": {"Bolivian Boliviano (1863–1963)", "Bolivian boliviano (1863–1963)", "Bolivian bolivianos (1863–1963)", "BOL", "", ""},
-	"BOP": {"Bolivian Peso", "Bolivian peso", "Bolivian pesos", "BOP", "", ""},
-	"BOV": {"Bolivian Mvdol", "Bolivian mvdol", "Bolivian mvdols", "BOV", "", ""},
-	"BRB": {"Brazilian New Cruzeiro (1967–1986)", "Brazilian new cruzeiro (1967–1986)", "Brazilian new cruzeiros (1967–1986)", "BRB", "", ""},
-	"BRC": {"Brazilian Cruzado (1986–1989)", "Brazilian cruzado (1986–1989)", "Brazilian cruzados (1986–1989)", "BRC", "", ""},
-	"BRE": {"Brazilian Cruzeiro (1990–1993)", "Brazilian cruzeiro (1990–1993)", "Brazilian cruzeiros (1990–1993)", "BRE", "", ""},
-	"BRL": {"Brazilian Real", "Brazilian real", "Brazilian reals", "R$", "R$", ""},
-	"BRN": {"Brazilian New Cruzado (1989–1990)", "Brazilian new cruzado (1989–1990)", "Brazilian new cruzados (1989–1990)", "BRN", "", ""},
-	"BRR": {"Brazilian Cruzeiro (1993–1994)", "Brazilian cruzeiro (1993–1994)", "Brazilian cruzeiros (1993–1994)", "BRR", "", ""},
-	"BRZ": {"Brazilian Cruzeiro (1942–1967)", "Brazilian cruzeiro (1942–1967)", "Brazilian cruzeiros (1942–1967)", "BRZ", "", ""},
-	"BSD": {"Bahamian Dollar", "Bahamian dollar", "Bahamian dollars", "BSD", "$", ""},
-	"BTN": {"Bhutanese Ngultrum", "Bhutanese ngultrum", "Bhutanese ngultrums", "BTN", "", ""},
-	"BUK": {"Burmese Kyat", "Burmese kyat", "Burmese kyats", "BUK", "", ""},
-	"BWP": {"Botswanan Pula", "Botswanan pula", "Botswanan pulas", "BWP", "P", ""},
-	"BYB": {"Belarusian Ruble (1994–1999)", "Belarusian ruble (1994–1999)", "Belarusian rubles (1994–1999)", "BYB", "", ""},
-	"BYN": {"Belarusian Ruble", "Belarusian ruble", "Belarusian rubles", "BYN", "р.", ""},
-	"BYR": {"Belarusian Ruble (2000–2016)", "Belarusian ruble (2000–2016)", "Belarusian rubles (2000–2016)", "BYR", "", ""},
-	"BZD": {"Belize Dollar", "Belize dollar", "Belize dollars", "BZD", "$", ""},
-	"CAD": {"Canadian Dollar", "Canadian dollar", "Canadian dollars", "CA$", "$", ""},
-	"CDF": {"Congolese Franc", "Congolese franc", "Congolese francs", "CDF", "", ""},
-	"CHE": {"WIR Euro", "WIR euro", "WIR euros", "CHE", "", ""},
-	"CHF": {"Swiss Franc", "Swiss franc", "Swiss francs", "CHF", "", ""},
-	"CHW": {"WIR Franc", "WIR franc", "WIR francs", "CHW", "", ""},
-	"CLE": {"Chilean Escudo", "Chilean escudo", "Chilean escudos", "CLE", "", ""},
-	"CLF": {"Chilean Unit of Account (UF)", "Chilean unit of account (UF)", "Chilean units of account (UF)", "CLF", "", ""},
-	"CLP": {"Chilean Peso", "Chilean peso", "Chilean pesos", "CLP", "$", ""},
-	"CNH": {"Chinese Yuan (offshore)", "Chinese yuan (offshore)", "Chinese yuan (offshore)", "CNH", "", ""},
-	"CNX": {"Chinese People’s Bank Dollar", "Chinese People’s Bank dollar", "Chinese People’s Bank dollars", "CNX", "", ""},
-	"CNY": {"Chinese Yuan", "Chinese yuan", "Chinese yuan", "CN¥", "¥", ""},
-	"COP": {"Colombian Peso", "Colombian peso", "Colombian pesos", "COP", "$", ""},
-	"COU": {"Colombian Real Value Unit", "Colombian real value unit", "Colombian real value units", "COU", "", ""},
-	"CRC": {"Costa Rican Colón", "Costa Rican colón", "Costa Rican colóns", "CRC", "₡", ""},
-	"CSD": {"Serbian Dinar (2002–2006)", "Serbian dinar (2002–2006)", "Serbian dinars (2002–2006)", "CSD", "", ""},
-	"CSK": {"Czechoslovak Hard Koruna", "Czechoslovak hard koruna", "Czechoslovak hard korunas", "CSK", "", ""},
-	"CUC": {"Cuban Convertible Peso", "Cuban convertible peso", "Cuban convertible pesos", "CUC", "$", ""},
-	"CUP": {"Cuban Peso", "Cuban peso", "Cuban pesos", "CUP", "$", ""},
-	"CVE": {"Cape Verdean Escudo", "Cape Verdean escudo", "Cape Verdean escudos", "CVE", "", ""},
-	"CYP": {"Cypriot Pound", "Cypriot pound", "Cypriot pounds", "CYP", "", ""},
-	"CZK": {"Czech Koruna", "Czech koruna", "Czech korunas", "CZK", "Kč", ""},
-	"DDM": {"East German Mark", "East German mark", "East German marks", "DDM", "", ""},
-	"DEM": {"German Mark", "German mark", "German marks", "DEM", "", ""},
-	"DJF": {"Djiboutian Franc", "Djiboutian franc", "Djiboutian francs", "DJF", "", ""},
-	"DKK": {"Danish Krone", "Danish krone", "Danish kroner", "DKK", "kr", ""},
-	"DOP": {"Dominican Peso", "Dominican peso", "Dominican pesos", "DOP", "$", ""},
-	"DZD": {"Algerian Dinar", "Algerian dinar", "Algerian dinars", "DZD", "", ""},
-	"ECS": {"Ecuadorian Sucre", "Ecuadorian sucre", "Ecuadorian sucres", "ECS", "", ""},
-	"ECV": {"Ecuadorian Unit of Constant Value", "Ecuadorian unit of constant value", "Ecuadorian units of constant value", "ECV", "", ""},
-	"EEK": {"Estonian Kroon", "Estonian kroon", "Estonian kroons", "EEK", "", ""},
-	"EGP": {"Egyptian Pound", "Egyptian pound", "Egyptian pounds", "EGP", "E£", ""},
-	"ERN": {"Eritrean Nakfa", "Eritrean nakfa", "Eritrean nakfas", "ERN", "", ""},
-	"ESA": {"Spanish Peseta (A account)", "Spanish peseta (A account)", "Spanish pesetas (A account)", "ESA", "", ""},
-	"ESB": {"Spanish Peseta (convertible account)", "Spanish peseta (convertible account)", "Spanish pesetas (convertible account)", "ESB", "", ""},
-	"ESP": {"Spanish Peseta", "Spanish peseta", "Spanish pesetas", "ESP", "₧", ""},
-	"ETB": {"Ethiopian Birr", "Ethiopian birr", "Ethiopian birrs", "ETB", "", ""},
-	"EUR": {"Euro", "euro", "euros", "€", "€", ""},
-	"FIM": {"Finnish Markka", "Finnish markka", "Finnish markkas", "FIM", "", ""},
-	"FJD": {"Fijian Dollar", "Fijian dollar", "Fijian dollars", "FJD", "$", ""},
-	"FKP": {"Falkland Islands Pound", "Falkland Islands pound", "Falkland Islands pounds", "FKP", "£", ""},
-	"FRF": {"French Franc", "French franc", "French francs", "FRF", "", ""},
-	"GBP": {"British Pound", "British pound", "British pounds", "£", "£", ""},
-	"GEK": {"Georgian Kupon Larit", "Georgian kupon larit", "Georgian kupon larits", "GEK", "", ""},
-	"GEL": {"Georgian Lari", "Georgian lari", "Georgian laris", "GEL", "₾", ""},
-	"GHC": {"Ghanaian Cedi (1979–2007)", "Ghanaian cedi (1979–2007)", "Ghanaian cedis (1979–2007)", "GHC", "", ""},
-	"GHS": {"Ghanaian Cedi", "Ghanaian cedi", "Ghanaian cedis", "GHS", "", ""},
-	"GIP": {"Gibraltar Pound", "Gibraltar pound", "Gibraltar pounds", "GIP", "£", ""},
-	"GMD": {"Gambian Dalasi", "Gambian dalasi", "Gambian dalasis", "GMD", "", ""},
-	"GNF": {"Guinean Franc", "Guinean franc", "Guinean francs", "GNF", "FG", ""},
-	"GNS": {"Guinean Syli", "Guinean syli", "Guinean sylis", "GNS", "", ""},
-	"GQE": {"Equatorial Guinean Ekwele", "Equatorial Guinean ekwele", "Equatorial Guinean ekwele", "GQE", "", ""},
-	"GRD": {"Greek Drachma", "Greek drachma", "Greek drachmas", "GRD", "", ""},
-	"GTQ": {"Guatemalan Quetzal", "Guatemalan quetzal", "Guatemalan quetzals", "GTQ", "Q", ""},
-	"GWE": {"Portuguese Guinea Escudo", "Portuguese Guinea escudo", "Portuguese Guinea escudos", "GWE", "", ""},
-	"GWP": {"Guinea-Bissau Peso", "Guinea-Bissau peso", "Guinea-Bissau pesos", "GWP", "", ""},
-	"GYD": {"Guyanaese Dollar", "Guyanaese dollar", "Guyanaese dollars", "GYD", "$", ""},
-	"HKD": {"Hong Kong Dollar", "Hong Kong dollar", "Hong Kong dollars", "HK$", "$", ""},
-	"HNL": {"Honduran Lempira", "Honduran lempira", "Honduran lempiras", "HNL", "L", ""},
-	"HRD": {"Croatian Dinar", "Croatian dinar", "Croatian dinars", "HRD", "", ""},
-	"HRK": {"Croatian Kuna", "Croatian kuna", "Croatian kunas", "HRK", "kn", ""},
-	"HTG": {"Haitian Gourde", "Haitian gourde", "Haitian gourdes", "HTG", "", ""},
-	"HUF": {"Hungarian Forint", "Hungarian forint", "Hungarian forints", "HUF", "Ft", ""},
-	"IDR": {"Indonesian Rupiah", "Indonesian rupiah", "Indonesian rupiahs", "IDR", "Rp", ""},
-	"IEP": {"Irish Pound", "Irish pound", "Irish pounds", "IEP", "", ""},
-	"ILP": {"Israeli Pound", "Israeli pound", "Israeli pounds", "ILP", "", ""},
-	"ILR": {"Israeli Shekel (1980–1985)", "Israeli shekel (1980–1985)", "Israeli shekels (1980–1985)", "ILR", "", ""},
-	"ILS": {"Israeli New Shekel", "Israeli new shekel", "Israeli new shekels", "₪", "₪", ""},
-	"INR": {"Indian Rupee", "Indian rupee", "Indian rupees", "₹", "₹", ""},
-	"IQD": {"Iraqi Dinar", "Iraqi dinar", "Iraqi dinars", "IQD", "", ""},
-	"IRR": {"Iranian Rial", "Iranian rial", "Iranian rials", "IRR", "", ""},
-	"ISJ": {"Icelandic Króna (1918–1981)", "Icelandic króna (1918–1981)", "Icelandic krónur (1918–1981)", "ISJ", "", ""},
-	"ISK": {"Icelandic Króna", "Icelandic króna", "Icelandic krónur", "ISK", "kr", ""},
-	"ITL": {"Italian Lira", "Italian lira", "Italian liras", "ITL", "", ""},
-	"JMD": {"Jamaican Dollar", "Jamaican dollar", "Jamaican dollars", "JMD", "$", ""},
-	"JOD": {"Jordanian Dinar", "Jordanian dinar", "Jordanian dinars", "JOD", "", ""},
-	"JPY": {"Japanese Yen", "Japanese yen", "Japanese yen", "¥", "¥", ""},
-	"KES": {"Kenyan Shilling", "Kenyan shilling", "Kenyan shillings", "KES", "", ""},
-	"KGS": {"Kyrgystani Som", "Kyrgystani som", "Kyrgystani soms", "KGS", "", ""},
-	"KHR": {"Cambodian Riel", "Cambodian riel", "Cambodian riels", "KHR", "៛", ""},
-	"KMF": {"Comorian Franc", "Comorian franc", "Comorian francs", "KMF", "CF", ""},
-	"KPW": {"North Korean Won", "North Korean won", "North Korean won", "KPW", "₩", ""},
-	"KRH": {"South Korean Hwan (1953–1962)", "South Korean hwan (1953–1962)", "South Korean hwan (1953–1962)", "KRH", "", ""},
-	"KRO": {"South Korean Won (1945–1953)", "South Korean won (1945–1953)", "South Korean won (1945–1953)", "KRO", "", ""},
-	"KRW": {"South Korean Won", "South Korean won", "South Korean won", "₩", "₩", ""},
-	"KWD": {"Kuwaiti Dinar", "Kuwaiti dinar", "Kuwaiti dinars", "KWD", "", ""},
-	"KYD": {"Cayman Islands Dollar", "Cayman Islands dollar", "Cayman Islands dollars", "KYD", "$", ""},
-	"KZT": {"Kazakhstani Tenge", "Kazakhstani tenge", "Kazakhstani tenges", "KZT", "₸", ""},
-	"LAK": {"Laotian Kip", "Laotian kip", "Laotian kips", "LAK", "₭", ""},
-	"LBP": {"Lebanese Pound", "Lebanese pound", "Lebanese pounds", "LBP", "L£", ""},
-	"LKR": {"Sri Lankan Rupee", "Sri Lankan rupee", "Sri Lankan rupees", "LKR", "Rs", ""},
-	"LRD": {"Liberian Dollar", "Liberian dollar", "Liberian dollars", "LRD", "$", ""},
-	"LSL": {"Lesotho Loti", "Lesotho loti", "Lesotho lotis", "LSL", "", ""},
-	"LTL": {"Lithuanian Litas", "Lithuanian litas", "Lithuanian litai", "LTL", "Lt", ""},
-	"LTT": {"Lithuanian Talonas", "Lithuanian talonas", "Lithuanian talonases", "LTT", "", ""},
-	"LUC": {"Luxembourgian Convertible Franc", "Luxembourgian convertible franc", "Luxembourgian convertible francs", "LUC", "", ""},
-	"LUF": {"Luxembourgian Franc", "Luxembourgian franc", "Luxembourgian francs", "LUF", "", ""},
-	"LUL": {"Luxembourg Financial Franc", "Luxembourg financial franc", "Luxembourg financial francs", "LUL", "", ""},
-	"LVL": {"Latvian Lats", "Latvian lats", "Latvian lati", "LVL", "Ls", ""},
-	"LVR": {"Latvian Ruble", "Latvian ruble", "Latvian rubles", "LVR", "", ""},
-	"LYD": {"Libyan Dinar", "Libyan dinar", "Libyan dinars", "LYD", "", ""},
-	"MAD": {"Moroccan Dirham", "Moroccan dirham", "Moroccan dirhams", "MAD", "", ""},
-	"MAF": {"Moroccan Franc", "Moroccan franc", "Moroccan francs", "MAF", "", ""},
-	"MCF": {"Monegasque Franc", "Monegasque franc", "Monegasque francs", "MCF", "", ""},
-	"MDC": {"Moldovan Cupon", "Moldovan cupon", "Moldovan cupon", "MDC", "", ""},
-	"MDL": {"Moldovan Leu", "Moldovan leu", "Moldovan lei", "MDL", "", ""},
-	"MGA": {"Malagasy Ariary", "Malagasy ariary", "Malagasy ariaries", "MGA", "Ar", ""},
-	"MGF": {"Malagasy Franc", "Malagasy franc", "Malagasy francs", "MGF", "", ""},
-	"MKD": {"Macedonian Denar", "Macedonian denar", "Macedonian denari", "MKD", "", ""},
-	"MKN": {"Macedonian Denar (1992–1993)", "Macedonian denar (1992–1993)", "Macedonian denari (1992–1993)", "MKN", "", ""},
-	"MLF": {"Malian Franc", "Malian franc", "Malian francs", "MLF", "", ""},
-	"MMK": {"Myanmar Kyat", "Myanmar kyat", "Myanmar kyats", "MMK", "K", ""},
-	"MNT": {"Mongolian Tugrik", "Mongolian tugrik", "Mongolian tugriks", "MNT", "₮", ""},
-	"MOP": {"Macanese Pataca", "Macanese pataca", "Macanese patacas", "MOP", "", ""},
-	"MRO": {"Mauritanian Ouguiya (1973–2017)", "Mauritanian ouguiya (1973–2017)", "Mauritanian ouguiyas (1973–2017)", "MRO", "", ""},
-	"MRU": {"Mauritanian Ouguiya", "Mauritanian ouguiya", "Mauritanian ouguiyas", "MRU", "", ""},
-	"MTL": {"Maltese Lira", "Maltese lira", "Maltese lira", "MTL", "", ""},
-	"MTP": {"Maltese Pound", "Maltese pound", "Maltese pounds", "MTP", "", ""},
-	"MUR": {"Mauritian Rupee", "Mauritian rupee", "Mauritian rupees", "MUR", "Rs", ""},
-	"MVP": {"Maldivian Rupee (1947–1981)", "Maldivian rupee (1947–1981)", "Maldivian rupees (1947–1981)", "MVP", "", ""},
-	"MVR": {"Maldivian Rufiyaa", "Maldivian rufiyaa", "Maldivian rufiyaas", "MVR", "", ""},
-	"MWK": {"Malawian Kwacha", "Malawian kwacha", "Malawian kwachas", "MWK", "", ""},
-	"MXN": {"Mexican Peso", "Mexican peso", "Mexican pesos", "MX$", "$", ""},
-	"MXP": {"Mexican Silver Peso (1861–1992)", "Mexican silver peso (1861–1992)", "Mexican silver pesos (1861–1992)", "MXP", "", ""},
-	"MXV": {"Mexican Investment Unit", "Mexican investment unit", "Mexican investment units", "MXV", "", ""},
-	"MYR": {"Malaysian Ringgit", "Malaysian ringgit", "Malaysian ringgits", "MYR", "RM", ""},
-	"MZE": {"Mozambican Escudo", "Mozambican escudo", "Mozambican escudos", "MZE", "", ""},
-	"MZM": {"Mozambican Metical (1980–2006)", "Mozambican metical (1980–2006)", "Mozambican meticals (1980–2006)", "MZM", "", ""},
-	"MZN": {"Mozambican Metical", "Mozambican metical", "Mozambican meticals", "MZN", "", ""},
-	"NAD": {"Namibian Dollar", "Namibian dollar", "Namibian dollars", "NAD", "$", ""},
-	"NGN": {"Nigerian Naira", "Nigerian naira", "Nigerian nairas", "NGN", "₦", ""},
-	"NIC": {"Nicaraguan Córdoba (1988–1991)", "Nicaraguan córdoba (1988–1991)", "Nicaraguan córdobas (1988–1991)", "NIC", "", ""},
-	"NIO": {"Nicaraguan Córdoba", "Nicaraguan córdoba", "Nicaraguan córdobas", "NIO", "C$", ""},
-	"NLG": {"Dutch Guilder", "Dutch guilder", "Dutch guilders", "NLG", "", ""},
-	"NOK": {"Norwegian Krone", "Norwegian krone", "Norwegian kroner", "NOK", "kr", ""},
-	"NPR": {"Nepalese Rupee", "Nepalese rupee", "Nepalese rupees", "NPR", "Rs", ""},
-	"NZD": {"New Zealand Dollar", "New Zealand dollar", "New Zealand dollars", "NZ$", "$", ""},
-	"OMR": {"Omani Rial", "Omani rial", "Omani rials", "OMR", "", ""},
-	"PAB": {"Panamanian Balboa", "Panamanian balboa", "Panamanian balboas", "PAB", "", ""},
-	"PEI": {"Peruvian Inti", "Peruvian inti", "Peruvian intis", "PEI", "", ""},
-	"PEN": {"Peruvian Sol", "Peruvian sol", "Peruvian soles", "PEN", "", ""},
-	"PES": {"Peruvian Sol (1863–1965)", "Peruvian sol (1863–1965)", "Peruvian soles (1863–1965)", "PES", "", ""},
-	"PGK": {"Papua New Guinean Kina", "Papua New Guinean kina", "Papua New Guinean kina", "PGK", "", ""},
-	"PHP": {"Philippine Piso", "Philippine piso", "Philippine pisos", "PHP", "₱", ""},
-	"PKR": {"Pakistani Rupee", "Pakistani rupee", "Pakistani rupees", "PKR", "Rs", ""},
-	"PLN": {"Polish Zloty", "Polish zloty", "Polish zlotys", "PLN", "zł", ""},
-	"PLZ": {"Polish Zloty (1950–1995)", "Polish zloty (PLZ)", "Polish zlotys (PLZ)", "PLZ", "", ""},
-	"PTE": {"Portuguese Escudo", "Portuguese escudo", "Portuguese escudos", "PTE", "", ""},
-	"PYG": {"Paraguayan Guarani", "Paraguayan guarani", "Paraguayan guaranis", "PYG", "₲", ""},
-	"QAR": {"Qatari Rial", "Qatari rial", "Qatari rials", "QAR", "", ""},
-	"RHD": {"Rhodesian Dollar", "Rhodesian dollar", "Rhodesian dollars", "RHD", "", ""},
-	"ROL": {"Romanian Leu (1952–2006)", "Romanian leu (1952–2006)", "Romanian Lei (1952–2006)", "ROL", "", ""},
-	"RON": {"Romanian Leu", "Romanian leu", "Romanian lei", "RON", "lei", ""},
-	"RSD": {"Serbian Dinar", "Serbian dinar", "Serbian dinars", "RSD", "", ""},
-	"RUB": {"Russian Ruble", "Russian ruble", "Russian rubles", "RUB", "₽", ""},
-	"RUR": {"Russian Ruble (1991–1998)", "Russian ruble (1991–1998)", "Russian rubles (1991–1998)", "RUR", "р.", ""},
-	"RWF": {"Rwandan Franc", "Rwandan franc", "Rwandan francs", "RWF", "RF", ""},
-	"SAR": {"Saudi Riyal", "Saudi riyal", "Saudi riyals", "SAR", "", ""},
-	"SBD": {"Solomon Islands Dollar", "Solomon Islands dollar", "Solomon Islands dollars", "SBD", "$", ""},
-	"SCR": {"Seychellois Rupee", "Seychellois rupee", "Seychellois rupees", "SCR", "", ""},
-	"SDD": {"Sudanese Dinar (1992–2007)", "Sudanese dinar (1992–2007)", "Sudanese dinars (1992–2007)", "SDD", "", ""},
-	"SDG": {"Sudanese Pound", "Sudanese pound", "Sudanese pounds", "SDG", "", ""},
-	"SDP": {"Sudanese Pound (1957–1998)", "Sudanese pound (1957–1998)", "Sudanese pounds (1957–1998)", "SDP", "", ""},
-	"SEK": {"Swedish Krona", "Swedish krona", "Swedish kronor", "SEK", "kr", ""},
-	"SGD": {"Singapore Dollar", "Singapore dollar", "Singapore dollars", "SGD", "$", ""},
-	"SHP": {"St. Helena Pound", "St. Helena pound", "St. Helena pounds", "SHP", "£", ""},
-	"SIT": {"Slovenian Tolar", "Slovenian tolar", "Slovenian tolars", "SIT", "", ""},
-	"SKK": {"Slovak Koruna", "Slovak koruna", "Slovak korunas", "SKK", "", ""},
-	"SLL": {"Sierra Leonean Leone", "Sierra Leonean leone", "Sierra Leonean leones", "SLL", "", ""},
-	"SOS": {"Somali Shilling", "Somali shilling", "Somali shillings", "SOS", "", ""},
-	"SRD": {"Surinamese Dollar", "Surinamese dollar", "Surinamese dollars", "SRD", "$", ""},
-	"SRG": {"Surinamese Guilder", "Surinamese guilder", "Surinamese guilders", "SRG", "", ""},
-	"SSP": {"South Sudanese Pound", "South Sudanese pound", "South Sudanese pounds", "SSP", "£", ""},
-	"STD": {"São Tomé & Príncipe Dobra (1977–2017)", "São Tomé & Príncipe dobra (1977–2017)", "São Tomé & Príncipe dobras (1977–2017)", "STD", "", ""},
-	"STN": {"São Tomé & Príncipe Dobra", "São Tomé & Príncipe dobra", "São Tomé & Príncipe dobras", "STN", "Db", ""},
-	"SUR": {"Soviet Rouble", "Soviet rouble", "Soviet roubles", "SUR", "", ""},
-	"SVC": {"Salvadoran Colón", "Salvadoran colón", "Salvadoran colones", "SVC", "", ""},
-	"SYP": {"Syrian Pound", "Syrian pound", "Syrian pounds", "SYP", "£", ""},
-	"SZL": {"Swazi Lilangeni", "Swazi lilangeni", "Swazi emalangeni", "SZL", "", ""},
-	"THB": {"Thai Baht", "Thai baht", "Thai baht", "THB", "฿", ""},
-	"TJR": {"Tajikistani Ruble", "Tajikistani ruble", "Tajikistani rubles", "TJR", "", ""},
-	"TJS": {"Tajikistani Somoni", "Tajikistani somoni", "Tajikistani somonis", "TJS", "", ""},
-	"TMM": {"Turkmenistani Manat (1993–2009)", "Turkmenistani manat (1993–2009)", "Turkmenistani manat (1993–2009)", "TMM", "", ""},
-	"TMT": {"Turkmenistani Manat", "Turkmenistani manat", "Turkmenistani manat", "TMT", "", ""},
-	"TND": {"Tunisian Dinar", "Tunisian dinar", "Tunisian dinars", "TND", "", ""},
-	"TOP": {"Tongan Paʻanga", "Tongan paʻanga", "Tongan paʻanga", "TOP", "T$", ""},
-	"TPE": {"Timorese Escudo", "Timorese escudo", "Timorese escudos", "TPE", "", ""},
-	"TRL": {"Turkish Lira (1922–2005)", "Turkish lira (1922–2005)", "Turkish Lira (1922–2005)", "TRL", "", ""},
-	"TRY": {"Turkish Lira", "Turkish lira", "Turkish Lira", "TRY", "₺", "TL"},
-	"TTD": {"Trinidad & Tobago Dollar", "Trinidad & Tobago dollar", "Trinidad & Tobago dollars", "TTD", "$", ""},
-	"TWD": {"New Taiwan Dollar", "New Taiwan dollar", "New Taiwan dollars", "NT$", "$", ""},
-	"TZS": {"Tanzanian Shilling", "Tanzanian shilling", "Tanzanian shillings", "TZS", "", ""},
-	"UAH": {"Ukrainian Hryvnia", "Ukrainian hryvnia", "Ukrainian hryvnias", "UAH", "₴", ""},
-	"UAK": {"Ukrainian Karbovanets", "Ukrainian karbovanets", "Ukrainian karbovantsiv", "UAK", "", ""},
-	"UGS": {"Ugandan Shilling (1966–1987)", "Ugandan shilling (1966–1987)", "Ugandan shillings (1966–1987)", "UGS", "", ""},
-	"UGX": {"Ugandan Shilling", "Ugandan shilling", "Ugandan shillings", "UGX", "", ""},
-	"USD": {"US Dollar", "US dollar", "US dollars", "$", "$", ""},
-	"USN": {"US Dollar (Next day)", "US dollar (next day)", "US dollars (next day)", "USN", "", ""},
-	"USS": {"US Dollar (Same day)", "US dollar (same day)", "US dollars (same day)", "USS", "", ""},
-	"UYI": {"Uruguayan Peso (Indexed Units)", "Uruguayan peso (indexed units)", "Uruguayan pesos (indexed units)", "UYI", "", ""},
-	"UYP": {"Uruguayan Peso (1975–1993)", "Uruguayan peso (1975–1993)", "Uruguayan pesos (1975–1993)", "UYP", "", ""},
-	"UYU": {"Uruguayan Peso", "Uruguayan peso", "Uruguayan pesos", "UYU", "$", ""},
-	"UYW": {"Uruguayan Nominal Wage Index Unit", "Uruguayan nominal wage index unit", "Uruguayan nominal wage index units", "UYW", "", ""},
-	"UZS": {"Uzbekistani Som", "Uzbekistani som", "Uzbekistani som", "UZS", "", ""},
-	"VEB": {"Venezuelan Bolívar (1871–2008)", "Venezuelan bolívar (1871–2008)", "Venezuelan bolívars (1871–2008)", "VEB", "", ""},
-	"VEF": {"Venezuelan Bolívar (2008–2018)", "Venezuelan bolívar (2008–2018)", "Venezuelan bolívars (2008–2018)", "VEF", "Bs", ""},
-	"VES": {"Venezuelan Bolívar", "Venezuelan bolívar", "Venezuelan bolívars", "VES", "", ""},
-	"VND": {"Vietnamese Dong", "Vietnamese dong", "Vietnamese dong", "₫", "₫", ""},
-	"VNN": {"Vietnamese Dong (1978–1985)", "Vietnamese dong (1978–1985)", "Vietnamese dong (1978–1985)", "VNN", "", ""},
-	"VUV": {"Vanuatu Vatu", "Vanuatu vatu", "Vanuatu vatus", "VUV", "", ""},
-	"WST": {"Samoan Tala", "Samoan tala", "Samoan tala", "WST", "", ""},
-	"XAF": {"Central African CFA Franc", "Central African CFA franc", "Central African CFA francs", "FCFA", "", ""},
-	"XAG": {"Silver", "troy ounce of silver", "troy ounces of silver", "XAG", "", ""},
-	"XAU": {"Gold", "troy ounce of gold", "troy ounces of gold", "XAU", "", ""},
-	"XBA": {"European Composite Unit", "European composite unit", "European composite units", "XBA", "", ""},
-	"XBB": {"European Monetary Unit", "European monetary unit", "European monetary units", "XBB", "", ""},
-	"XBC": {"European Unit of Account (XBC)", "European unit of account (XBC)", "European units of account (XBC)", "XBC", "", ""},
-	"XBD": {"European Unit of Account (XBD)", "European unit of account (XBD)", "European units of account (XBD)", "XBD", "", ""},
-	"XCD": {"East Caribbean Dollar", "East Caribbean dollar", "East Caribbean dollars", "EC$", "$", ""},
-	"XDR": {"Special Drawing Rights", "special drawing rights", "special drawing rights", "XDR", "", ""},
-	"XEU": {"European Currency Unit", "European currency unit", "European currency units", "XEU", "", ""},
-	"XFO": {"French Gold Franc", "French gold franc", "French gold francs", "XFO", "", ""},
-	"XFU": {"French UIC-Franc", "French UIC-franc", "French UIC-francs", "XFU", "", ""},
-	"XOF": {"West African CFA Franc", "West African CFA franc", "West African CFA francs", "CFA", "", ""},
-	"XPD": {"Palladium", "troy ounce of palladium", "troy ounces of palladium", "XPD", "", ""},
-	"XPF": {"CFP Franc", "CFP franc", "CFP francs", "CFPF", "", ""},
-	"XPT": {"Platinum", "troy ounce of platinum", "troy ounces of platinum", "XPT", "", ""},
-	"XRE": {"RINET Funds", "RINET Funds unit", "RINET Funds units", "XRE", "", ""},
-	"XSU": {"Sucre", "Sucre", "Sucres", "XSU", "", ""},
-	"XTS": {"Testing Currency Code", "Testing Currency unit", "Testing Currency units", "XTS", "", ""},
-	"XUA": {"ADB Unit of Account", "ADB unit of account", "ADB units of account", "XUA", "", ""},
-	"XXX": {"Unknown Currency", "(unknown unit of currency)", "(unknown currency)", "¤", "", ""},
-	"YDD": {"Yemeni Dinar", "Yemeni dinar", "Yemeni dinars", "YDD", "", ""},
-	"YER": {"Yemeni Rial", "Yemeni rial", "Yemeni rials", "YER", "", ""},
-	"YUD": {"Yugoslavian Hard Dinar (1966–1990)", "Yugoslavian hard dinar (1966–1990)", "Yugoslavian hard dinars (1966–1990)", "YUD", "", ""},
-	"YUM": {"Yugoslavian New Dinar (1994–2002)", "Yugoslavian new dinar (1994–2002)", "Yugoslavian new dinars (1994–2002)", "YUM", "", ""},
-	"YUN": {"Yugoslavian Convertible Dinar (1990–1992)", "Yugoslavian convertible dinar (1990–1992)", "Yugoslavian convertible dinars (1990–1992)", "YUN", "", ""},
-	"YUR": {"Yugoslavian Reformed Dinar (1992–1993)", "Yugoslavian reformed dinar (1992–1993)", "Yugoslavian reformed dinars (1992–1993)", "YUR", "", ""},
-	"ZAL": {"South African Rand (financial)", "South African rand (financial)", "South African rands (financial)", "ZAL", "", ""},
-	"ZAR": {"South African Rand", "South African rand", "South African rand", "ZAR", "R", ""},
-	"ZMK": {"Zambian Kwacha (1968–2012)", "Zambian kwacha (1968–2012)", "Zambian kwachas (1968–2012)", "ZMK", "", ""},
-	"ZMW": {"Zambian Kwacha", "Zambian kwacha", "Zambian kwachas", "ZMW", "ZK", ""},
-	"ZRN": {"Zairean New Zaire (1993–1998)", "Zairean new zaire (1993–1998)", "Zairean new zaires (1993–1998)", "ZRN", "", ""},
-	"ZRZ": {"Zairean Zaire (1971–1993)", "Zairean zaire (1971–1993)", "Zairean zaires (1971–1993)", "ZRZ", "", ""},
-	"ZWD": {"Zimbabwean Dollar (1980–2008)", "Zimbabwean dollar (1980–2008)", "Zimbabwean dollars (1980–2008)", "ZWD", "", ""},
-	"ZWL": {"Zimbabwean Dollar (2009)", "Zimbabwean dollar (2009)", "Zimbabwean dollars (2009)", "ZWL", "", ""},
-	"ZWR": {"Zimbabwean Dollar (2008)", "Zimbabwean dollar (2008)", "Zimbabwean dollars (2008)", "ZWR", "", ""},
+	"ADP": {DisplayName: "Andorran Peseta", DisplayNameCountOne: "Andorran peseta", DisplayNameCountOther: "Andorran pesetas", Symbol: "ADP"},
+	"AED": {DisplayName: "United Arab Emirates Dirham", DisplayNameCountOne: "UAE dirham", DisplayNameCountOther: "UAE dirhams", Symbol: "AED"},
+	"AFA": {DisplayName: "Afghan Afghani (1927–2002)", DisplayNameCountOne: "Afghan afghani (1927–2002)", DisplayNameCountOther: "Afghan afghanis (1927–2002)", Symbol: "AFA"},
+	"AFN": {DisplayName: "Afghan Afghani", DisplayNameCountOne: "Afghan Afghani", DisplayNameCountOther: "Afghan Afghanis", Symbol: "AFN"},
+	"ALK": {DisplayName: "Albanian Lek (1946–1965)", DisplayNameCountOne: "Albanian lek (1946–1965)", DisplayNameCountOther: "Albanian lekë (1946–1965)", Symbol: "ALK"},
+	"ALL": {DisplayName: "Albanian Lek", DisplayNameCountOne: "Albanian lek", DisplayNameCountOther: "Albanian lekë", Symbol: "ALL"},
+	"AMD": {DisplayName: "Armenian Dram", DisplayNameCountOne: "Armenian dram", DisplayNameCountOther: "Armenian drams", Symbol: "AMD"},
+	"ANG": {DisplayName: "Netherlands Antillean Guilder", DisplayNameCountOne: "Netherlands Antillean guilder", DisplayNameCountOther: "Netherlands Antillean guilders", Symbol: "ANG"},
+	"AOA": {DisplayName: "Angolan Kwanza", DisplayNameCountOne: "Angolan kwanza", DisplayNameCountOther: "Angolan kwanzas", Symbol: "AOA", SymbolAltNarrow: "Kz"},
+	"AOK": {DisplayName: "Angolan Kwanza (1977–1991)", DisplayNameCountOne: "Angolan kwanza (1977–1991)", DisplayNameCountOther: "Angolan kwanzas (1977–1991)", Symbol: "AOK"},
+	"AON": {DisplayName: "Angolan New Kwanza (1990–2000)", DisplayNameCountOne: "Angolan new kwanza (1990–2000)", DisplayNameCountOther: "Angolan new kwanzas (1990–2000)", Symbol: "AON"},
+	"AOR": {DisplayName: "Angolan Readjusted Kwanza (1995–1999)", DisplayNameCountOne: "Angolan readjusted kwanza (1995–1999)", DisplayNameCountOther: "Angolan readjusted kwanzas (1995–1999)", Symbol: "AOR"},
+	"ARA": {DisplayName: "Argentine Austral", DisplayNameCountOne: "Argentine austral", DisplayNameCountOther: "Argentine australs", Symbol: "ARA"},
+	"ARL": {DisplayName: "Argentine Peso Ley (1970–1983)", DisplayNameCountOne: "Argentine peso ley (1970–1983)", DisplayNameCountOther: "Argentine pesos ley (1970–1983)", Symbol: "ARL"},
+	"ARM": {DisplayName: "Argentine Peso (1881–1970)", DisplayNameCountOne: "Argentine peso (1881–1970)", DisplayNameCountOther: "Argentine pesos (1881–1970)", Symbol: "ARM"},
+	"ARP": {DisplayName: "Argentine Peso (1983–1985)", DisplayNameCountOne: "Argentine peso (1983–1985)", DisplayNameCountOther: "Argentine pesos (1983–1985)", Symbol: "ARP"},
+	"ARS": {DisplayName: "Argentine Peso", DisplayNameCountOne: "Argentine peso", DisplayNameCountOther: "Argentine pesos", Symbol: "ARS", SymbolAltNarrow: "$"},
+	"ATS": {DisplayName: "Austrian Schilling", DisplayNameCountOne: "Austrian schilling", DisplayNameCountOther: "Austrian schillings", Symbol: "ATS"},
+	"AUD": {DisplayName: "Australian Dollar", DisplayNameCountOne: "Australian dollar", DisplayNameCountOther: "Australian dollars", Symbol: "A$", SymbolAltNarrow: "$"},
+	"AWG": {DisplayName: "Aruban Florin", DisplayNameCountOne: "Aruban florin", DisplayNameCountOther: "Aruban florin", Symbol: "AWG"},
+	"AZM": {DisplayName: "Azerbaijani Manat (1993–2006)", DisplayNameCountOne: "Azerbaijani manat (1993–2006)", DisplayNameCountOther: "Azerbaijani manats (1993–2006)", Symbol: "AZM"},
+	"AZN": {DisplayName: "Azerbaijani Manat", DisplayNameCountOne: "Azerbaijani manat", DisplayNameCountOther: "Azerbaijani manats", Symbol: "AZN"},
+	"BAD": {DisplayName: "Bosnia-Herzegovina Dinar (1992–1994)", DisplayNameCountOne: "Bosnia-Herzegovina dinar (1992–1994)", DisplayNameCountOther: "Bosnia-Herzegovina dinars (1992–1994)", Symbol: "BAD"},
+	"BAM": {DisplayName: "Bosnia-Herzegovina Convertible Mark", DisplayNameCountOne: "Bosnia-Herzegovina convertible mark", DisplayNameCountOther: "Bosnia-Herzegovina convertible marks", Symbol: "BAM", SymbolAltNarrow: "KM"},
+	"BAN": {DisplayName: "Bosnia-Herzegovina New Dinar (1994–1997)", DisplayNameCountOne: "Bosnia-Herzegovina new dinar (1994–1997)", DisplayNameCountOther: "Bosnia-Herzegovina new dinars (1994–1997)", Symbol: "BAN"},
+	"BBD": {DisplayName: "Barbadian Dollar", DisplayNameCountOne: "Barbadian dollar", DisplayNameCountOther: "Barbadian dollars", Symbol: "BBD", SymbolAltNarrow: "$"},
+	"BDT": {DisplayName: "Bangladeshi Taka", DisplayNameCountOne: "Bangladeshi taka", DisplayNameCountOther: "Bangladeshi takas", Symbol: "BDT", SymbolAltNarrow: "৳"},
+	"BEC": {DisplayName: "Belgian Franc (convertible)", DisplayNameCountOne: "Belgian franc (convertible)", DisplayNameCountOther: "Belgian francs (convertible)", Symbol: "BEC"},
+	"BEF": {DisplayName: "Belgian Franc", DisplayNameCountOne: "Belgian franc", DisplayNameCountOther: "Belgian francs", Symbol: "BEF"},
+	"BEL": {DisplayName: "Belgian Franc (financial)", DisplayNameCountOne: "Belgian franc (financial)", DisplayNameCountOther: "Belgian francs (financial)", Symbol: "BEL"},
+	"BGL": {DisplayName: "Bulgarian Hard Lev", DisplayNameCountOne: "Bulgarian hard lev", DisplayNameCountOther: "Bulgarian hard leva", Symbol: "BGL"},
+	"BGM": {DisplayName: "Bulgarian Socialist Lev", DisplayNameCountOne: "Bulgarian socialist lev", DisplayNameCountOther: "Bulgarian socialist leva", Symbol: "BGM"},
+	"BGN": {DisplayName: "Bulgarian Lev", DisplayNameCountOne: "Bulgarian lev", DisplayNameCountOther: "Bulgarian leva", Symbol: "BGN"},
+	"BGO": {DisplayName: "Bulgarian Lev (1879–1952)", DisplayNameCountOne: "Bulgarian lev (1879–1952)", DisplayNameCountOther: "Bulgarian leva (1879–1952)", Symbol: "BGO"},
+	"BHD": {DisplayName: "Bahraini Dinar", DisplayNameCountOne: "Bahraini dinar", DisplayNameCountOther: "Bahraini dinars", Symbol: "BHD"},
+	"BIF": {DisplayName: "Burundian Franc", DisplayNameCountOne: "Burundian franc", DisplayNameCountOther: "Burundian francs", Symbol: "BIF"},
+	"BMD": {DisplayName: "Bermudan Dollar", DisplayNameCountOne: "Bermudan dollar", DisplayNameCountOther: "Bermudan dollars", Symbol: "BMD", SymbolAltNarrow: "$"},
+	"BND": {DisplayName: "Brunei Dollar", DisplayNameCountOne: "Brunei dollar", DisplayNameCountOther: "Brunei dollars", Symbol: "BND", SymbolAltNarrow: "$"},
+	"BOB": {DisplayName: "Bolivian Boliviano", DisplayNameCountOne: "Bolivian boliviano", DisplayNameCountOther: "Bolivian bolivianos", Symbol: "BOB", SymbolAltNarrow: "Bs"},
+	"BOL": {DisplayName: "Bolivian Boliviano (1863–1963)", DisplayNameCountOne: "Bolivian boliviano (1863–1963)", DisplayNameCountOther: "Bolivian bolivianos (1863–1963)", Symbol: "BOL"},
+	"BOP": {DisplayName: "Bolivian Peso", DisplayNameCountOne: "Bolivian peso", DisplayNameCountOther: "Bolivian pesos", Symbol: "BOP"},
+	"BOV": {DisplayName: "Bolivian Mvdol", DisplayNameCountOne: "Bolivian mvdol", DisplayNameCountOther: "Bolivian mvdols", Symbol: "BOV"},
+	"BRB": {DisplayName: "Brazilian New Cruzeiro (1967–1986)", DisplayNameCountOne: "Brazilian new cruzeiro (1967–1986)", DisplayNameCountOther: "Brazilian new cruzeiros (1967–1986)", Symbol: "BRB"},
+	"BRC": {DisplayName: "Brazilian Cruzado (1986–1989)", DisplayNameCountOne: "Brazilian cruzado (1986–1989)", DisplayNameCountOther: "Brazilian cruzados (1986–1989)", Symbol: "BRC"},
+	"BRE": {DisplayName: "Brazilian Cruzeiro (1990–1993)", DisplayNameCountOne: "Brazilian cruzeiro (1990–1993)", DisplayNameCountOther: "Brazilian cruzeiros (1990–1993)", Symbol: "BRE"},
+	"BRL": {DisplayName: "Brazilian Real", DisplayNameCountOne: "Brazilian real", DisplayNameCountOther: "Brazilian reals", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"BRN": {DisplayName: "Brazilian New Cruzado (1989–1990)", DisplayNameCountOne: "Brazilian new cruzado (1989–1990)", DisplayNameCountOther: "Brazilian new cruzados (1989–1990)", Symbol: "BRN"},
+	"BRR": {DisplayName: "Brazilian Cruzeiro (1993–1994)", DisplayNameCountOne: "Brazilian cruzeiro (1993–1994)", DisplayNameCountOther: "Brazilian cruzeiros (1993–1994)", Symbol: "BRR"},
+	"BRZ": {DisplayName: "Brazilian Cruzeiro (1942–1967)", DisplayNameCountOne: "Brazilian cruzeiro (1942–1967)", DisplayNameCountOther: "Brazilian cruzeiros (1942–1967)", Symbol: "BRZ"},
+	"BSD": {DisplayName: "Bahamian Dollar", DisplayNameCountOne: "Bahamian dollar", DisplayNameCountOther: "Bahamian dollars", Symbol: "BSD", SymbolAltNarrow: "$"},
+	"BTN": {DisplayName: "Bhutanese Ngultrum", DisplayNameCountOne: "Bhutanese ngultrum", DisplayNameCountOther: "Bhutanese ngultrums", Symbol: "BTN"},
+	"BUK": {DisplayName: "Burmese Kyat", DisplayNameCountOne: "Burmese kyat", DisplayNameCountOther: "Burmese kyats", Symbol: "BUK"},
+	"BWP": {DisplayName: "Botswanan Pula", DisplayNameCountOne: "Botswanan pula", DisplayNameCountOther: "Botswanan pulas", Symbol: "BWP", SymbolAltNarrow: "P"},
+	"BYB": {DisplayName: "Belarusian Ruble (1994–1999)", DisplayNameCountOne: "Belarusian ruble (1994–1999)", DisplayNameCountOther: "Belarusian rubles (1994–1999)", Symbol: "BYB"},
+	"BYN": {DisplayName: "Belarusian Ruble", DisplayNameCountOne: "Belarusian ruble", DisplayNameCountOther: "Belarusian rubles", Symbol: "BYN", SymbolAltNarrow: "р."},
+	"BYR": {DisplayName: "Belarusian Ruble (2000–2016)", DisplayNameCountOne: "Belarusian ruble (2000–2016)", DisplayNameCountOther: "Belarusian rubles (2000–2016)", Symbol: "BYR"},
+	"BZD": {DisplayName: "Belize Dollar", DisplayNameCountOne: "Belize dollar", DisplayNameCountOther: "Belize dollars", Symbol: "BZD", SymbolAltNarrow: "$"},
+	"CAD": {DisplayName: "Canadian Dollar", DisplayNameCountOne: "Canadian dollar", DisplayNameCountOther: "Canadian dollars", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CDF": {DisplayName: "Congolese Franc", DisplayNameCountOne: "Congolese franc", DisplayNameCountOther: "Congolese francs", Symbol: "CDF"},
+	"CHE": {DisplayName: "WIR Euro", DisplayNameCountOne: "WIR euro", DisplayNameCountOther: "WIR euros", Symbol: "CHE"},
+	"CHF": {DisplayName: "Swiss Franc", DisplayNameCountOne: "Swiss franc", DisplayNameCountOther: "Swiss francs", Symbol: "CHF"},
+	"CHW": {DisplayName: "WIR Franc", DisplayNameCountOne: "WIR franc", DisplayNameCountOther: "WIR francs", Symbol: "CHW"},
+	"CLE": {DisplayName: "Chilean Escudo", DisplayNameCountOne: "Chilean escudo", DisplayNameCountOther: "Chilean escudos", Symbol: "CLE"},
+	"CLF": {DisplayName: "Chilean Unit of Account (UF)", DisplayNameCountOne: "Chilean unit of account (UF)", DisplayNameCountOther: "Chilean units of account (UF)", Symbol: "CLF"},
+	"CLP": {DisplayName: "Chilean Peso", DisplayNameCountOne: "Chilean peso", DisplayNameCountOther: "Chilean pesos", Symbol: "CLP", SymbolAltNarrow: "$"},
+	"CNH": {DisplayName: "Chinese Yuan (offshore)", DisplayNameCountOne: "Chinese yuan (offshore)", DisplayNameCountOther: "Chinese yuan (offshore)", Symbol: "CNH"},
+	"CNX": {DisplayName: "Chinese People’s Bank Dollar", DisplayNameCountOne: "Chinese People’s Bank dollar", DisplayNameCountOther: "Chinese People’s Bank dollars", Symbol: "CNX"},
+	"CNY": {DisplayName: "Chinese Yuan", DisplayNameCountOne: "Chinese yuan", DisplayNameCountOther: "Chinese yuan", Symbol: "CN¥", SymbolAltNarrow: "¥"},
+	"COP": {DisplayName: "Colombian Peso", DisplayNameCountOne: "Colombian peso", DisplayNameCountOther: "Colombian pesos", Symbol: "COP", SymbolAltNarrow: "$"},
+	"COU": {DisplayName: "Colombian Real Value Unit", DisplayNameCountOne: "Colombian real value unit", DisplayNameCountOther: "Colombian real value units", Symbol: "COU"},
+	"CRC": {DisplayName: "Costa Rican Colón", DisplayNameCountOne: "Costa Rican colón", DisplayNameCountOther: "Costa Rican colóns", Symbol: "CRC", SymbolAltNarrow: "₡"},
+	"CSD": {DisplayName: "Serbian Dinar (2002–2006)", DisplayNameCountOne: "Serbian dinar (2002–2006)", DisplayNameCountOther: "Serbian dinars (2002–2006)", Symbol: "CSD"},
+	"CSK": {DisplayName: "Czechoslovak Hard Koruna", DisplayNameCountOne: "Czechoslovak hard koruna", DisplayNameCountOther: "Czechoslovak hard korunas", Symbol: "CSK"},
+	"CUC": {DisplayName: "Cuban Convertible Peso", DisplayNameCountOne: "Cuban convertible peso", DisplayNameCountOther: "Cuban convertible pesos", Symbol: "CUC", SymbolAltNarrow: "$"},
+	"CUP": {DisplayName: "Cuban Peso", DisplayNameCountOne: "Cuban peso", DisplayNameCountOther: "Cuban pesos", Symbol: "CUP", SymbolAltNarrow: "$"},
+	"CVE": {DisplayName: "Cape Verdean Escudo", DisplayNameCountOne: "Cape Verdean escudo", DisplayNameCountOther: "Cape Verdean escudos", Symbol: "CVE"},
+	"CYP": {DisplayName: "Cypriot Pound", DisplayNameCountOne: "Cypriot pound", DisplayNameCountOther: "Cypriot pounds", Symbol: "CYP"},
+	"CZK": {DisplayName: "Czech Koruna", DisplayNameCountOne: "Czech koruna", DisplayNameCountOther: "Czech korunas", Symbol: "CZK", SymbolAltNarrow: "Kč"},
+	"DDM": {DisplayName: "East German Mark", DisplayNameCountOne: "East German mark", DisplayNameCountOther: "East German marks", Symbol: "DDM"},
+	"DEM": {DisplayName: "German Mark", DisplayNameCountOne: "German mark", DisplayNameCountOther: "German marks", Symbol: "DEM"},
+	"DJF": {DisplayName: "Djiboutian Franc", DisplayNameCountOne: "Djiboutian franc", DisplayNameCountOther: "Djiboutian francs", Symbol: "DJF"},
+	"DKK": {DisplayName: "Danish Krone", DisplayNameCountOne: "Danish krone", DisplayNameCountOther: "Danish kroner", Symbol: "DKK", SymbolAltNarrow: "kr"},
+	"DOP": {DisplayName: "Dominican Peso", DisplayNameCountOne: "Dominican peso", DisplayNameCountOther: "Dominican pesos", Symbol: "DOP", SymbolAltNarrow: "$"},
+	"DZD": {DisplayName: "Algerian Dinar", DisplayNameCountOne: "Algerian dinar", DisplayNameCountOther: "Algerian dinars", Symbol: "DZD"},
+	"ECS": {DisplayName: "Ecuadorian Sucre", DisplayNameCountOne: "Ecuadorian sucre", DisplayNameCountOther: "Ecuadorian sucres", Symbol: "ECS"},
+	"ECV": {DisplayName: "Ecuadorian Unit of Constant Value", DisplayNameCountOne: "Ecuadorian unit of constant value", DisplayNameCountOther: "Ecuadorian units of constant value", Symbol: "ECV"},
+	"EEK": {DisplayName: "Estonian Kroon", DisplayNameCountOne: "Estonian kroon", DisplayNameCountOther: "Estonian kroons", Symbol: "EEK"},
+	"EGP": {DisplayName: "Egyptian Pound", DisplayNameCountOne: "Egyptian pound", DisplayNameCountOther: "Egyptian pounds", Symbol: "EGP", SymbolAltNarrow: "E£"},
+	"ERN": {DisplayName: "Eritrean Nakfa", DisplayNameCountOne: "Eritrean nakfa", DisplayNameCountOther: "Eritrean nakfas", Symbol: "ERN"},
+	"ESA": {DisplayName: "Spanish Peseta (A account)", DisplayNameCountOne: "Spanish peseta (A account)", DisplayNameCountOther: "Spanish pesetas (A account)", Symbol: "ESA"},
+	"ESB": {DisplayName: "Spanish Peseta (convertible account)", DisplayNameCountOne: "Spanish peseta (convertible account)", DisplayNameCountOther: "Spanish pesetas (convertible account)", Symbol: "ESB"},
+	"ESP": {DisplayName: "Spanish Peseta", DisplayNameCountOne: "Spanish peseta", DisplayNameCountOther: "Spanish pesetas", Symbol: "ESP", SymbolAltNarrow: "₧"},
+	"ETB": {DisplayName: "Ethiopian Birr", DisplayNameCountOne: "Ethiopian birr", DisplayNameCountOther: "Ethiopian birrs", Symbol: "ETB"},
+	"EUR": {DisplayName: "Euro", DisplayNameCountOne: "euro", DisplayNameCountOther: "euros", Symbol: "€", SymbolAltNarrow: "€"},
+	"FIM": {DisplayName: "Finnish Markka", DisplayNameCountOne: "Finnish markka", DisplayNameCountOther: "Finnish markkas", Symbol: "FIM"},
+	"FJD": {DisplayName: "Fijian Dollar", DisplayNameCountOne: "Fijian dollar", DisplayNameCountOther: "Fijian dollars", Symbol: "FJD", SymbolAltNarrow: "$"},
+	"FKP": {DisplayName: "Falkland Islands Pound", DisplayNameCountOne: "Falkland Islands pound", DisplayNameCountOther: "Falkland Islands pounds", Symbol: "FKP", SymbolAltNarrow: "£"},
+	"FRF": {DisplayName: "French Franc", DisplayNameCountOne: "French franc", DisplayNameCountOther: "French francs", Symbol: "FRF"},
+	"GBP": {DisplayName: "British Pound", DisplayNameCountOne: "British pound", DisplayNameCountOther: "British pounds", Symbol: "£", SymbolAltNarrow: "£"},
+	"GEK": {DisplayName: "Georgian Kupon Larit", DisplayNameCountOne: "Georgian kupon larit", DisplayNameCountOther: "Georgian kupon larits", Symbol: "GEK"},
+	"GEL": {DisplayName: "Georgian Lari", DisplayNameCountOne: "Georgian lari", DisplayNameCountOther: "Georgian laris", Symbol: "GEL", SymbolAltNarrow: "₾"},
+	"GHC": {DisplayName: "Ghanaian Cedi (1979–2007)", DisplayNameCountOne: "Ghanaian cedi (1979–2007)", DisplayNameCountOther: "Ghanaian cedis (1979–2007)", Symbol: "GHC"},
+	"GHS": {DisplayName: "Ghanaian Cedi", DisplayNameCountOne: "Ghanaian cedi", DisplayNameCountOther: "Ghanaian cedis", Symbol: "GHS"},
+	"GIP": {DisplayName: "Gibraltar Pound", DisplayNameCountOne: "Gibraltar pound", DisplayNameCountOther: "Gibraltar pounds", Symbol: "GIP", SymbolAltNarrow: "£"},
+	"GMD": {DisplayName: "Gambian Dalasi", DisplayNameCountOne: "Gambian dalasi", DisplayNameCountOther: "Gambian dalasis", Symbol: "GMD"},
+	"GNF": {DisplayName: "Guinean Franc", DisplayNameCountOne: "Guinean franc", DisplayNameCountOther: "Guinean francs", Symbol: "GNF", SymbolAltNarrow: "FG"},
+	"GNS": {DisplayName: "Guinean Syli", DisplayNameCountOne: "Guinean syli", DisplayNameCountOther: "Guinean sylis", Symbol: "GNS"},
+	"GQE": {DisplayName: "Equatorial Guinean Ekwele", DisplayNameCountOne: "Equatorial Guinean ekwele", DisplayNameCountOther: "Equatorial Guinean ekwele", Symbol: "GQE"},
+	"GRD": {DisplayName: "Greek Drachma", DisplayNameCountOne: "Greek drachma", DisplayNameCountOther: "Greek drachmas", Symbol: "GRD"},
+	"GTQ": {DisplayName: "Guatemalan Quetzal", DisplayNameCountOne: "Guatemalan quetzal", DisplayNameCountOther: "Guatemalan quetzals", Symbol: "GTQ", SymbolAltNarrow: "Q"},
+	"GWE": {DisplayName: "Portuguese Guinea Escudo", DisplayNameCountOne: "Portuguese Guinea escudo", DisplayNameCountOther: "Portuguese Guinea escudos", Symbol: "GWE"},
+	"GWP": {DisplayName: "Guinea-Bissau Peso", DisplayNameCountOne: "Guinea-Bissau peso", DisplayNameCountOther: "Guinea-Bissau pesos", Symbol: "GWP"},
+	"GYD": {DisplayName: "Guyanaese Dollar", DisplayNameCountOne: "Guyanaese dollar", DisplayNameCountOther: "Guyanaese dollars", Symbol: "GYD", SymbolAltNarrow: "$"},
+	"HKD": {DisplayName: "Hong Kong Dollar", DisplayNameCountOne: "Hong Kong dollar", DisplayNameCountOther: "Hong Kong dollars", Symbol: "HK$", SymbolAltNarrow: "$"},
+	"HNL": {DisplayName: "Honduran Lempira", DisplayNameCountOne: "Honduran lempira", DisplayNameCountOther: "Honduran lempiras", Symbol: "HNL", SymbolAltNarrow: "L"},
+	"HRD": {DisplayName: "Croatian Dinar", DisplayNameCountOne: "Croatian dinar", DisplayNameCountOther: "Croatian dinars", Symbol: "HRD"},
+	"HRK": {DisplayName: "Croatian Kuna", DisplayNameCountOne: "Croatian kuna", DisplayNameCountOther: "Croatian kunas", Symbol: "HRK", SymbolAltNarrow: "kn"},
+	"HTG": {DisplayName: "Haitian Gourde", DisplayNameCountOne: "Haitian gourde", DisplayNameCountOther: "Haitian gourdes", Symbol: "HTG"},
+	"HUF": {DisplayName: "Hungarian Forint", DisplayNameCountOne: "Hungarian forint", DisplayNameCountOther: "Hungarian forints", Symbol: "HUF", SymbolAltNarrow: "Ft"},
+	"IDR": {DisplayName: "Indonesian Rupiah", DisplayNameCountOne: "Indonesian rupiah", DisplayNameCountOther: "Indonesian rupiahs", Symbol: "IDR", SymbolAltNarrow: "Rp"},
+	"IEP": {DisplayName: "Irish Pound", DisplayNameCountOne: "Irish pound", DisplayNameCountOther: "Irish pounds", Symbol: "IEP"},
+	"ILP": {DisplayName: "Israeli Pound", DisplayNameCountOne: "Israeli pound", DisplayNameCountOther: "Israeli pounds", Symbol: "ILP"},
+	"ILR": {DisplayName: "Israeli Shekel (1980–1985)", DisplayNameCountOne: "Israeli shekel (1980–1985)", DisplayNameCountOther: "Israeli shekels (1980–1985)", Symbol: "ILR"},
+	"ILS": {DisplayName: "Israeli New Shekel", DisplayNameCountOne: "Israeli new shekel", DisplayNameCountOther: "Israeli new shekels", Symbol: "₪", SymbolAltNarrow: "₪"},
+	"INR": {DisplayName: "Indian Rupee", DisplayNameCountOne: "Indian rupee", DisplayNameCountOther: "Indian rupees", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"IQD": {DisplayName: "Iraqi Dinar", DisplayNameCountOne: "Iraqi dinar", DisplayNameCountOther: "Iraqi dinars", Symbol: "IQD"},
+	"IRR": {DisplayName: "Iranian Rial", DisplayNameCountOne: "Iranian rial", DisplayNameCountOther: "Iranian rials", Symbol: "IRR"},
+	"ISJ": {DisplayName: "Icelandic Króna (1918–1981)", DisplayNameCountOne: "Icelandic króna (1918–1981)", DisplayNameCountOther: "Icelandic krónur (1918–1981)", Symbol: "ISJ"},
+	"ISK": {DisplayName: "Icelandic Króna", DisplayNameCountOne: "Icelandic króna", DisplayNameCountOther: "Icelandic krónur", Symbol: "ISK", SymbolAltNarrow: "kr"},
+	"ITL": {DisplayName: "Italian Lira", DisplayNameCountOne: "Italian lira", DisplayNameCountOther: "Italian liras", Symbol: "ITL"},
+	"JMD": {DisplayName: "Jamaican Dollar", DisplayNameCountOne: "Jamaican dollar", DisplayNameCountOther: "Jamaican dollars", Symbol: "JMD", SymbolAltNarrow: "$"},
+	"JOD": {DisplayName: "Jordanian Dinar", DisplayNameCountOne: "Jordanian dinar", DisplayNameCountOther: "Jordanian dinars", Symbol: "JOD"},
+	"JPY": {DisplayName: "Japanese Yen", DisplayNameCountOne: "Japanese yen", DisplayNameCountOther: "Japanese yen", Symbol: "¥", SymbolAltNarrow: "¥"},
+	"KES": {DisplayName: "Kenyan Shilling", DisplayNameCountOne: "Kenyan shilling", DisplayNameCountOther: "Kenyan shillings", Symbol: "KES"},
+	"KGS": {DisplayName: "Kyrgystani Som", DisplayNameCountOne: "Kyrgystani som", DisplayNameCountOther: "Kyrgystani soms", Symbol: "KGS"},
+	"KHR": {DisplayName: "Cambodian Riel", DisplayNameCountOne: "Cambodian riel", DisplayNameCountOther: "Cambodian riels", Symbol: "KHR", SymbolAltNarrow: "៛"},
+	"KMF": {DisplayName: "Comorian Franc", DisplayNameCountOne: "Comorian franc", DisplayNameCountOther: "Comorian francs", Symbol: "KMF", SymbolAltNarrow: "CF"},
+	"KPW": {DisplayName: "North Korean Won", DisplayNameCountOne: "North Korean won", DisplayNameCountOther: "North Korean won", Symbol: "KPW", SymbolAltNarrow: "₩"},
+	"KRH": {DisplayName: "South Korean Hwan (1953–1962)", DisplayNameCountOne: "South Korean hwan (1953–1962)", DisplayNameCountOther: "South Korean hwan (1953–1962)", Symbol: "KRH"},
+	"KRO": {DisplayName: "South Korean Won (1945–1953)", DisplayNameCountOne: "South Korean won (1945–1953)", DisplayNameCountOther: "South Korean won (1945–1953)", Symbol: "KRO"},
+	"KRW": {DisplayName: "South Korean Won", DisplayNameCountOne: "South Korean won", DisplayNameCountOther: "South Korean won", Symbol: "₩", SymbolAltNarrow: "₩"},
+	"KWD": {DisplayName: "Kuwaiti Dinar", DisplayNameCountOne: "Kuwaiti dinar", DisplayNameCountOther: "Kuwaiti dinars", Symbol: "KWD"},
+	"KYD": {DisplayName: "Cayman Islands Dollar", DisplayNameCountOne: "Cayman Islands dollar", DisplayNameCountOther: "Cayman Islands dollars", Symbol: "KYD", SymbolAltNarrow: "$"},
+	"KZT": {DisplayName: "Kazakhstani Tenge", DisplayNameCountOne: "Kazakhstani tenge", DisplayNameCountOther: "Kazakhstani tenges", Symbol: "KZT", SymbolAltNarrow: "₸"},
+	"LAK": {DisplayName: "Laotian Kip", DisplayNameCountOne: "Laotian kip", DisplayNameCountOther: "Laotian kips", Symbol: "LAK", SymbolAltNarrow: "₭"},
+	"LBP": {DisplayName: "Lebanese Pound", DisplayNameCountOne: "Lebanese pound", DisplayNameCountOther: "Lebanese pounds", Symbol: "LBP", SymbolAltNarrow: "L£"},
+	"LKR": {DisplayName: "Sri Lankan Rupee", DisplayNameCountOne: "Sri Lankan rupee", DisplayNameCountOther: "Sri Lankan rupees", Symbol: "LKR", SymbolAltNarrow: "Rs"},
+	"LRD": {DisplayName: "Liberian Dollar", DisplayNameCountOne: "Liberian dollar", DisplayNameCountOther: "Liberian dollars", Symbol: "LRD", SymbolAltNarrow: "$"},
+	"LSL": {DisplayName: "Lesotho Loti", DisplayNameCountOne: "Lesotho loti", DisplayNameCountOther: "Lesotho lotis", Symbol: "LSL"},
+	"LTL": {DisplayName: "Lithuanian Litas", DisplayNameCountOne: "Lithuanian litas", DisplayNameCountOther: "Lithuanian litai", Symbol: "LTL", SymbolAltNarrow: "Lt"},
+	"LTT": {DisplayName: "Lithuanian Talonas", DisplayNameCountOne: "Lithuanian talonas", DisplayNameCountOther: "Lithuanian talonases", Symbol: "LTT"},
+	"LUC": {DisplayName: "Luxembourgian Convertible Franc", DisplayNameCountOne: "Luxembourgian convertible franc", DisplayNameCountOther: "Luxembourgian convertible francs", Symbol: "LUC"},
+	"LUF": {DisplayName: "Luxembourgian Franc", DisplayNameCountOne: "Luxembourgian franc", DisplayNameCountOther: "Luxembourgian francs", Symbol: "LUF"},
+	"LUL": {DisplayName: "Luxembourg Financial Franc", DisplayNameCountOne: "Luxembourg financial franc", DisplayNameCountOther: "Luxembourg financial francs", Symbol: "LUL"},
+	"LVL": {DisplayName: "Latvian Lats", DisplayNameCountOne: "Latvian lats", DisplayNameCountOther: "Latvian lati", Symbol: "LVL", SymbolAltNarrow: "Ls"},
+	"LVR": {DisplayName: "Latvian Ruble", DisplayNameCountOne: "Latvian ruble", DisplayNameCountOther: "Latvian rubles", Symbol: "LVR"},
+	"LYD": {DisplayName: "Libyan Dinar", DisplayNameCountOne: "Libyan dinar", DisplayNameCountOther: "Libyan dinars", Symbol: "LYD"},
+	"MAD": {DisplayName: "Moroccan Dirham", DisplayNameCountOne: "Moroccan dirham", DisplayNameCountOther: "Moroccan dirhams", Symbol: "MAD"},
+	"MAF": {DisplayName: "Moroccan Franc", DisplayNameCountOne: "Moroccan franc", DisplayNameCountOther: "Moroccan francs", Symbol: "MAF"},
+	"MCF": {DisplayName: "Monegasque Franc", DisplayNameCountOne: "Monegasque franc", DisplayNameCountOther: "Monegasque francs", Symbol: "MCF"},
+	"MDC": {DisplayName: "Moldovan Cupon", DisplayNameCountOne: "Moldovan cupon", DisplayNameCountOther: "Moldovan cupon", Symbol: "MDC"},
+	"MDL": {DisplayName: "Moldovan Leu", DisplayNameCountOne: "Moldovan leu", DisplayNameCountOther: "Moldovan lei", Symbol: "MDL"},
+	"MGA": {DisplayName: "Malagasy Ariary", DisplayNameCountOne: "Malagasy ariary", DisplayNameCountOther: "Malagasy ariaries", Symbol: "MGA", SymbolAltNarrow: "Ar"},
+	"MGF": {DisplayName: "Malagasy Franc", DisplayNameCountOne: "Malagasy franc", DisplayNameCountOther: "Malagasy francs", Symbol: "MGF"},
+	"MKD": {DisplayName: "Macedonian Denar", DisplayNameCountOne: "Macedonian denar", DisplayNameCountOther: "Macedonian denari", Symbol: "MKD"},
+	"MKN": {DisplayName: "Macedonian Denar (1992–1993)", DisplayNameCountOne: "Macedonian denar (1992–1993)", DisplayNameCountOther: "Macedonian denari (1992–1993)", Symbol: "MKN"},
+	"MLF": {DisplayName: "Malian Franc", DisplayNameCountOne: "Malian franc", DisplayNameCountOther: "Malian francs", Symbol: "MLF"},
+	"MMK": {DisplayName: "Myanmar Kyat", DisplayNameCountOne: "Myanmar kyat", DisplayNameCountOther: "Myanmar kyats", Symbol: "MMK", SymbolAltNarrow: "K"},
+	"MNT": {DisplayName: "Mongolian Tugrik", DisplayNameCountOne: "Mongolian tugrik", DisplayNameCountOther: "Mongolian tugriks", Symbol: "MNT", SymbolAltNarrow: "₮"},
+	"MOP": {DisplayName: "Macanese Pataca", DisplayNameCountOne: "Macanese pataca", DisplayNameCountOther: "Macanese patacas", Symbol: "MOP"},
+	"MRO": {DisplayName: "Mauritanian Ouguiya (1973–2017)", DisplayNameCountOne: "Mauritanian ouguiya (1973–2017)", DisplayNameCountOther: "Mauritanian ouguiyas (1973–2017)", Symbol: "MRO"},
+	"MRU": {DisplayName: "Mauritanian Ouguiya", DisplayNameCountOne: "Mauritanian ouguiya", DisplayNameCountOther: "Mauritanian ouguiyas", Symbol: "MRU"},
+	"MTL": {DisplayName: "Maltese Lira", DisplayNameCountOne: "Maltese lira", DisplayNameCountOther: "Maltese lira", Symbol: "MTL"},
+	"MTP": {DisplayName: "Maltese Pound", DisplayNameCountOne: "Maltese pound", DisplayNameCountOther: "Maltese pounds", Symbol: "MTP"},
+	"MUR": {DisplayName: "Mauritian Rupee", DisplayNameCountOne: "Mauritian rupee", DisplayNameCountOther: "Mauritian rupees", Symbol: "MUR", SymbolAltNarrow: "Rs"},
+	"MVP": {DisplayName: "Maldivian Rupee (1947–1981)", DisplayNameCountOne: "Maldivian rupee (1947–1981)", DisplayNameCountOther: "Maldivian rupees (1947–1981)", Symbol: "MVP"},
+	"MVR": {DisplayName: "Maldivian Rufiyaa", DisplayNameCountOne: "Maldivian rufiyaa", DisplayNameCountOther: "Maldivian rufiyaas", Symbol: "MVR"},
+	"MWK": {DisplayName: "Malawian Kwacha", DisplayNameCountOne: "Malawian kwacha", DisplayNameCountOther: "Malawian kwachas", Symbol: "MWK"},
+	"MXN": {DisplayName: "Mexican Peso", DisplayNameCountOne: "Mexican peso", DisplayNameCountOther: "Mexican pesos", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"MXP": {DisplayName: "Mexican Silver Peso (1861–1992)", DisplayNameCountOne: "Mexican silver peso (1861–1992)", DisplayNameCountOther: "Mexican silver pesos (1861–1992)", Symbol: "MXP"},
+	"MXV": {DisplayName: "Mexican Investment Unit", DisplayNameCountOne: "Mexican investment unit", DisplayNameCountOther: "Mexican investment units", Symbol: "MXV"},
+	"MYR": {DisplayName: "Malaysian Ringgit", DisplayNameCountOne: "Malaysian ringgit", DisplayNameCountOther: "Malaysian ringgits", Symbol: "MYR", SymbolAltNarrow: "RM"},
+	"MZE": {DisplayName: "Mozambican Escudo", DisplayNameCountOne: "Mozambican escudo", DisplayNameCountOther: "Mozambican escudos", Symbol: "MZE"},
+	"MZM": {DisplayName: "Mozambican Metical (1980–2006)", DisplayNameCountOne: "Mozambican metical (1980–2006)", DisplayNameCountOther: "Mozambican meticals (1980–2006)", Symbol: "MZM"},
+	"MZN": {DisplayName: "Mozambican Metical", DisplayNameCountOne: "Mozambican metical", DisplayNameCountOther: "Mozambican meticals", Symbol: "MZN"},
+	"NAD": {DisplayName: "Namibian Dollar", DisplayNameCountOne: "Namibian dollar", DisplayNameCountOther: "Namibian dollars", Symbol: "NAD", SymbolAltNarrow: "$"},
+	"NGN": {DisplayName: "Nigerian Naira", DisplayNameCountOne: "Nigerian naira", DisplayNameCountOther: "Nigerian nairas", Symbol: "NGN", SymbolAltNarrow: "₦"},
+	"NIC": {DisplayName: "Nicaraguan Córdoba (1988–1991)", DisplayNameCountOne: "Nicaraguan córdoba (1988–1991)", DisplayNameCountOther: "Nicaraguan córdobas (1988–1991)", Symbol: "NIC"},
+	"NIO": {DisplayName: "Nicaraguan Córdoba", DisplayNameCountOne: "Nicaraguan córdoba", DisplayNameCountOther: "Nicaraguan córdobas", Symbol: "NIO", SymbolAltNarrow: "C$"},
+	"NLG": {DisplayName: "Dutch Guilder", DisplayNameCountOne: "Dutch guilder", DisplayNameCountOther: "Dutch guilders", Symbol: "NLG"},
+	"NOK": {DisplayName: "Norwegian Krone", DisplayNameCountOne: "Norwegian krone", DisplayNameCountOther: "Norwegian kroner", Symbol: "NOK", SymbolAltNarrow: "kr"},
+	"NPR": {DisplayName: "Nepalese Rupee", DisplayNameCountOne: "Nepalese rupee", DisplayNameCountOther: "Nepalese rupees", Symbol: "NPR", SymbolAltNarrow: "Rs"},
+	"NZD": {DisplayName: "New Zealand Dollar", DisplayNameCountOne: "New Zealand dollar", DisplayNameCountOther: "New Zealand dollars", Symbol: "NZ$", SymbolAltNarrow: "$"},
+	"OMR": {DisplayName: "Omani Rial", DisplayNameCountOne: "Omani rial", DisplayNameCountOther: "Omani rials", Symbol: "OMR"},
+	"PAB": {DisplayName: "Panamanian Balboa", DisplayNameCountOne: "Panamanian balboa", DisplayNameCountOther: "Panamanian balboas", Symbol: "PAB"},
+	"PEI": {DisplayName: "Peruvian Inti", DisplayNameCountOne: "Peruvian inti", DisplayNameCountOther: "Peruvian intis", Symbol: "PEI"},
+	"PEN": {DisplayName: "Peruvian Sol", DisplayNameCountOne: "Peruvian sol", DisplayNameCountOther: "Peruvian soles", Symbol: "PEN"},
+	"PES": {DisplayName: "Peruvian Sol (1863–1965)", DisplayNameCountOne: "Peruvian sol (1863–1965)", DisplayNameCountOther: "Peruvian soles (1863–1965)", Symbol: "PES"},
+	"PGK": {DisplayName: "Papua New Guinean Kina", DisplayNameCountOne: "Papua New Guinean kina", DisplayNameCountOther: "Papua New Guinean kina", Symbol: "PGK"},
+	"PHP": {DisplayName: "Philippine Piso", DisplayNameCountOne: "Philippine piso", DisplayNameCountOther: "Philippine pisos", Symbol: "PHP", SymbolAltNarrow: "₱"},
+	"PKR": {DisplayName: "Pakistani Rupee", DisplayNameCountOne: "Pakistani rupee", DisplayNameCountOther: "Pakistani rupees", Symbol: "PKR", SymbolAltNarrow: "Rs"},
+	"PLN": {DisplayName: "Polish Zloty", DisplayNameCountOne: "Polish zloty", DisplayNameCountOther: "Polish zlotys", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"PLZ": {DisplayName: "Polish Zloty (1950–1995)", DisplayNameCountOne: "Polish zloty (PLZ)", DisplayNameCountOther: "Polish zlotys (PLZ)", Symbol: "PLZ"},
+	"PTE": {DisplayName: "Portuguese Escudo", DisplayNameCountOne: "Portuguese escudo", DisplayNameCountOther: "Portuguese escudos", Symbol: "PTE"},
+	"PYG": {DisplayName: "Paraguayan Guarani", DisplayNameCountOne: "Paraguayan guarani", DisplayNameCountOther: "Paraguayan guaranis", Symbol: "PYG", SymbolAltNarrow: "₲"},
+	"QAR": {DisplayName: "Qatari Rial", DisplayNameCountOne: "Qatari rial", DisplayNameCountOther: "Qatari rials", Symbol: "QAR"},
+	"RHD": {DisplayName: "Rhodesian Dollar", DisplayNameCountOne: "Rhodesian dollar", DisplayNameCountOther: "Rhodesian dollars", Symbol: "RHD"},
+	"ROL": {DisplayName: "Romanian Leu (1952–2006)", DisplayNameCountOne: "Romanian leu (1952–2006)", DisplayNameCountOther: "Romanian Lei (1952–2006)", Symbol: "ROL"},
+	"RON": {DisplayName: "Romanian Leu", DisplayNameCountOne: "Romanian leu", DisplayNameCountOther: "Romanian lei", Symbol: "RON", SymbolAltNarrow: "lei"},
+	"RSD": {DisplayName: "Serbian Dinar", DisplayNameCountOne: "Serbian dinar", DisplayNameCountOther: "Serbian dinars", Symbol: "RSD"},
+	"RUB": {DisplayName: "Russian Ruble", DisplayNameCountOne: "Russian ruble", DisplayNameCountOther: "Russian rubles", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"RUR": {DisplayName: "Russian Ruble (1991–1998)", DisplayNameCountOne: "Russian ruble (1991–1998)", DisplayNameCountOther: "Russian rubles (1991–1998)", Symbol: "RUR", SymbolAltNarrow: "р."},
+	"RWF": {DisplayName: "Rwandan Franc", DisplayNameCountOne: "Rwandan franc", DisplayNameCountOther: "Rwandan francs", Symbol: "RWF", SymbolAltNarrow: "RF"},
+	"SAR": {DisplayName: "Saudi Riyal", DisplayNameCountOne: "Saudi riyal", DisplayNameCountOther: "Saudi riyals", Symbol: "SAR"},
+	"SBD": {DisplayName: "Solomon Islands Dollar", DisplayNameCountOne: "Solomon Islands dollar", DisplayNameCountOther: "Solomon Islands dollars", Symbol: "SBD", SymbolAltNarrow: "$"},
+	"SCR": {DisplayName: "Seychellois Rupee", DisplayNameCountOne: "Seychellois rupee", DisplayNameCountOther: "Seychellois rupees", Symbol: "SCR"},
+	"SDD": {DisplayName: "Sudanese Dinar (1992–2007)", DisplayNameCountOne: "Sudanese dinar (1992–2007)", DisplayNameCountOther: "Sudanese dinars (1992–2007)", Symbol: "SDD"},
+	"SDG": {DisplayName: "Sudanese Pound", DisplayNameCountOne: "Sudanese pound", DisplayNameCountOther: "Sudanese pounds", Symbol: "SDG"},
+	"SDP": {DisplayName: "Sudanese Pound (1957–1998)", DisplayNameCountOne: "Sudanese pound (1957–1998)", DisplayNameCountOther: "Sudanese pounds (1957–1998)", Symbol: "SDP"},
+	"SEK": {DisplayName: "Swedish Krona", DisplayNameCountOne: "Swedish krona", DisplayNameCountOther: "Swedish kronor", Symbol: "SEK", SymbolAltNarrow: "kr"},
+	"SGD": {DisplayName: "Singapore Dollar", DisplayNameCountOne: "Singapore dollar", DisplayNameCountOther: "Singapore dollars", Symbol: "SGD", SymbolAltNarrow: "$"},
+	"SHP": {DisplayName: "St. Helena Pound", DisplayNameCountOne: "St. Helena pound", DisplayNameCountOther: "St. Helena pounds", Symbol: "SHP", SymbolAltNarrow: "£"},
+	"SIT": {DisplayName: "Slovenian Tolar", DisplayNameCountOne: "Slovenian tolar", DisplayNameCountOther: "Slovenian tolars", Symbol: "SIT"},
+	"SKK": {DisplayName: "Slovak Koruna", DisplayNameCountOne: "Slovak koruna", DisplayNameCountOther: "Slovak korunas", Symbol: "SKK"},
+	"SLL": {DisplayName: "Sierra Leonean Leone", DisplayNameCountOne: "Sierra Leonean leone", DisplayNameCountOther: "Sierra Leonean leones", Symbol: "SLL"},
+	"SOS": {DisplayName: "Somali Shilling", DisplayNameCountOne: "Somali shilling", DisplayNameCountOther: "Somali shillings", Symbol: "SOS"},
+	"SRD": {DisplayName: "Surinamese Dollar", DisplayNameCountOne: "Surinamese dollar", DisplayNameCountOther: "Surinamese dollars", Symbol: "SRD", SymbolAltNarrow: "$"},
+	"SRG": {DisplayName: "Surinamese Guilder", DisplayNameCountOne: "Surinamese guilder", DisplayNameCountOther: "Surinamese guilders", Symbol: "SRG"},
+	"SSP": {DisplayName: "South Sudanese Pound", DisplayNameCountOne: "South Sudanese pound", DisplayNameCountOther: "South Sudanese pounds", Symbol: "SSP", SymbolAltNarrow: "£"},
+	"STD": {DisplayName: "São Tomé & Príncipe Dobra (1977–2017)", DisplayNameCountOne: "São Tomé & Príncipe dobra (1977–2017)", DisplayNameCountOther: "São Tomé & Príncipe dobras (1977–2017)", Symbol: "STD"},
+	"STN": {DisplayName: "São Tomé & Príncipe Dobra", DisplayNameCountOne: "São Tomé & Príncipe dobra", DisplayNameCountOther: "São Tomé & Príncipe dobras", Symbol: "STN", SymbolAltNarrow: "Db"},
+	"SUR": {DisplayName: "Soviet Rouble", DisplayNameCountOne: "Soviet rouble", DisplayNameCountOther: "Soviet roubles", Symbol: "SUR"},
+	"SVC": {DisplayName: "Salvadoran Colón", DisplayNameCountOne: "Salvadoran colón", DisplayNameCountOther: "Salvadoran colones", Symbol: "SVC"},
+	"SYP": {DisplayName: "Syrian Pound", DisplayNameCountOne: "Syrian pound", DisplayNameCountOther: "Syrian pounds", Symbol: "SYP", SymbolAltNarrow: "£"},
+	"SZL": {DisplayName: "Swazi Lilangeni", DisplayNameCountOne: "Swazi lilangeni", DisplayNameCountOther: "Swazi emalangeni", Symbol: "SZL"},
+	"THB": {DisplayName: "Thai Baht", DisplayNameCountOne: "Thai baht", DisplayNameCountOther: "Thai baht", Symbol: "THB", SymbolAltNarrow: "฿"},
+	"TJR": {DisplayName: "Tajikistani Ruble", DisplayNameCountOne: "Tajikistani ruble", DisplayNameCountOther: "Tajikistani rubles", Symbol: "TJR"},
+	"TJS": {DisplayName: "Tajikistani Somoni", DisplayNameCountOne: "Tajikistani somoni", DisplayNameCountOther: "Tajikistani somonis", Symbol: "TJS"},
+	"TMM": {DisplayName: "Turkmenistani Manat (1993–2009)", DisplayNameCountOne: "Turkmenistani manat (1993–2009)", DisplayNameCountOther: "Turkmenistani manat (1993–2009)", Symbol: "TMM"},
+	"TMT": {DisplayName: "Turkmenistani Manat", DisplayNameCountOne: "Turkmenistani manat", DisplayNameCountOther: "Turkmenistani manat", Symbol: "TMT"},
+	"TND": {DisplayName: "Tunisian Dinar", DisplayNameCountOne: "Tunisian dinar", DisplayNameCountOther: "Tunisian dinars", Symbol: "TND"},
+	"TOP": {DisplayName: "Tongan Paʻanga", DisplayNameCountOne: "Tongan paʻanga", DisplayNameCountOther: "Tongan paʻanga", Symbol: "TOP", SymbolAltNarrow: "T$"},
+	"TPE": {DisplayName: "Timorese Escudo", DisplayNameCountOne: "Timorese escudo", DisplayNameCountOther: "Timorese escudos", Symbol: "TPE"},
+	"TRL": {DisplayName: "Turkish Lira (1922–2005)", DisplayNameCountOne: "Turkish lira (1922–2005)", DisplayNameCountOther: "Turkish Lira (1922–2005)", Symbol: "TRL"},
+	"TRY": {DisplayName: "Turkish Lira", DisplayNameCountOne: "Turkish lira", DisplayNameCountOther: "Turkish Lira", Symbol: "TRY", SymbolAltNarrow: "₺", SymbolAltVariant: "TL"},
+	"TTD": {DisplayName: "Trinidad & Tobago Dollar", DisplayNameCountOne: "Trinidad & Tobago dollar", DisplayNameCountOther: "Trinidad & Tobago dollars", Symbol: "TTD", SymbolAltNarrow: "$"},
+	"TWD": {DisplayName: "New Taiwan Dollar", DisplayNameCountOne: "New Taiwan dollar", DisplayNameCountOther: "New Taiwan dollars", Symbol: "NT$", SymbolAltNarrow: "$"},
+	"TZS": {DisplayName: "Tanzanian Shilling", DisplayNameCountOne: "Tanzanian shilling", DisplayNameCountOther: "Tanzanian shillings", Symbol: "TZS"},
+	"UAH": {DisplayName: "Ukrainian Hryvnia", DisplayNameCountOne: "Ukrainian hryvnia", DisplayNameCountOther: "Ukrainian hryvnias", Symbol: "UAH", SymbolAltNarrow: "₴"},
+	"UAK": {DisplayName: "Ukrainian Karbovanets", DisplayNameCountOne: "Ukrainian karbovanets", DisplayNameCountOther: "Ukrainian karbovantsiv", Symbol: "UAK"},
+	"UGS": {DisplayName: "Ugandan Shilling (1966–1987)", DisplayNameCountOne: "Ugandan shilling (1966–1987)", DisplayNameCountOther: "Ugandan shillings (1966–1987)", Symbol: "UGS"},
+	"UGX": {DisplayName: "Ugandan Shilling", DisplayNameCountOne: "Ugandan shilling", DisplayNameCountOther: "Ugandan shillings", Symbol: "UGX"},
+	"USD": {DisplayName: "US Dollar", DisplayNameCountOne: "US dollar", DisplayNameCountOther: "US dollars", Symbol: "$", SymbolAltNarrow: "$"},
+	"USN": {DisplayName: "US Dollar (Next day)", DisplayNameCountOne: "US dollar (next day)", DisplayNameCountOther: "US dollars (next day)", Symbol: "USN"},
+	"USS": {DisplayName: "US Dollar (Same day)", DisplayNameCountOne: "US dollar (same day)", DisplayNameCountOther: "US dollars (same day)", Symbol: "USS"},
+	"UYI": {DisplayName: "Uruguayan Peso (Indexed Units)", DisplayNameCountOne: "Uruguayan peso (indexed units)", DisplayNameCountOther: "Uruguayan pesos (indexed units)", Symbol: "UYI"},
+	"UYP": {DisplayName: "Uruguayan Peso (1975–1993)", DisplayNameCountOne: "Uruguayan peso (1975–1993)", DisplayNameCountOther: "Uruguayan pesos (1975–1993)", Symbol: "UYP"},
+	"UYU": {DisplayName: "Uruguayan Peso", DisplayNameCountOne: "Uruguayan peso", DisplayNameCountOther: "Uruguayan pesos", Symbol: "UYU", SymbolAltNarrow: "$"},
+	"UYW": {DisplayName: "Uruguayan Nominal Wage Index Unit", DisplayNameCountOne: "Uruguayan nominal wage index unit", DisplayNameCountOther: "Uruguayan nominal wage index units", Symbol: "UYW"},
+	"UZS": {DisplayName: "Uzbekistani Som", DisplayNameCountOne: "Uzbekistani som", DisplayNameCountOther: "Uzbekistani som", Symbol: "UZS"},
+	"VEB": {DisplayName: "Venezuelan Bolívar (1871–2008)", DisplayNameCountOne: "Venezuelan bolívar (1871–2008)", DisplayNameCountOther: "Venezuelan bolívars (1871–2008)", Symbol: "VEB"},
+	"VEF": {DisplayName: "Venezuelan Bolívar (2008–2018)", DisplayNameCountOne: "Venezuelan bolívar (2008–2018)", DisplayNameCountOther: "Venezuelan bolívars (2008–2018)", Symbol: "VEF", SymbolAltNarrow: "Bs"},
+	"VES": {DisplayName: "Venezuelan Bolívar", DisplayNameCountOne: "Venezuelan bolívar", DisplayNameCountOther: "Venezuelan bolívars", Symbol: "VES"},
+	"VND": {DisplayName: "Vietnamese Dong", DisplayNameCountOne: "Vietnamese dong", DisplayNameCountOther: "Vietnamese dong", Symbol: "₫", SymbolAltNarrow: "₫"},
+	"VNN": {DisplayName: "Vietnamese Dong (1978–1985)", DisplayNameCountOne: "Vietnamese dong (1978–1985)", DisplayNameCountOther: "Vietnamese dong (1978–1985)", Symbol: "VNN"},
+	"VUV": {DisplayName: "Vanuatu Vatu", DisplayNameCountOne: "Vanuatu vatu", DisplayNameCountOther: "Vanuatu vatus", Symbol: "VUV"},
+	"WST": {DisplayName: "Samoan Tala", DisplayNameCountOne: "Samoan tala", DisplayNameCountOther: "Samoan tala", Symbol: "WST"},
+	"XAF": {DisplayName: "Central African CFA Franc", DisplayNameCountOne: "Central African CFA franc", DisplayNameCountOther: "Central African CFA francs", Symbol: "FCFA"},
+	"XAG": {DisplayName: "Silver", DisplayNameCountOne: "troy ounce of silver", DisplayNameCountOther: "troy ounces of silver", Symbol: "XAG"},
+	"XAU": {DisplayName: "Gold", DisplayNameCountOne: "troy ounce of gold", DisplayNameCountOther: "troy ounces of gold", Symbol: "XAU"},
+	"XBA": {DisplayName: "European Composite Unit", DisplayNameCountOne: "European composite unit", DisplayNameCountOther: "European composite units", Symbol: "XBA"},
+	"XBB": {DisplayName: "European Monetary Unit", DisplayNameCountOne: "European monetary unit", DisplayNameCountOther: "European monetary units", Symbol: "XBB"},
+	"XBC": {DisplayName: "European Unit of Account (XBC)", DisplayNameCountOne: "European unit of account (XBC)", DisplayNameCountOther: "European units of account (XBC)", Symbol: "XBC"},
+	"XBD": {DisplayName: "European Unit of Account (XBD)", DisplayNameCountOne: "European unit of account (XBD)", DisplayNameCountOther: "European units of account (XBD)", Symbol: "XBD"},
+	"XCD": {DisplayName: "East Caribbean Dollar", DisplayNameCountOne: "East Caribbean dollar", DisplayNameCountOther: "East Caribbean dollars", Symbol: "EC$", SymbolAltNarrow: "$"},
+	"XDR": {DisplayName: "Special Drawing Rights", DisplayNameCountOne: "special drawing rights", DisplayNameCountOther: "special drawing rights", Symbol: "XDR"},
+	"XEU": {DisplayName: "European Currency Unit", DisplayNameCountOne: "European currency unit", DisplayNameCountOther: "European currency units", Symbol: "XEU"},
+	"XFO": {DisplayName: "French Gold Franc", DisplayNameCountOne: "French gold franc", DisplayNameCountOther: "French gold francs", Symbol: "XFO"},
+	"XFU": {DisplayName: "French UIC-Franc", DisplayNameCountOne: "French UIC-franc", DisplayNameCountOther: "French UIC-francs", Symbol: "XFU"},
+	"XOF": {DisplayName: "West African CFA Franc", DisplayNameCountOne: "West African CFA franc", DisplayNameCountOther: "West African CFA francs", Symbol: "CFA"},
+	"XPD": {DisplayName: "Palladium", DisplayNameCountOne: "troy ounce of palladium", DisplayNameCountOther: "troy ounces of palladium", Symbol: "XPD"},
+	"XPF": {DisplayName: "CFP Franc", DisplayNameCountOne: "CFP franc", DisplayNameCountOther: "CFP francs", Symbol: "CFPF"},
+	"XPT": {DisplayName: "Platinum", DisplayNameCountOne: "troy ounce of platinum", DisplayNameCountOther: "troy ounces of platinum", Symbol: "XPT"},
+	"XRE": {DisplayName: "RINET Funds", DisplayNameCountOne: "RINET Funds unit", DisplayNameCountOther: "RINET Funds units", Symbol: "XRE"},
+	"XSU": {DisplayName: "Sucre", DisplayNameCountOne: "Sucre", DisplayNameCountOther: "Sucres", Symbol: "XSU"},
+	"XTS": {DisplayName: "Testing Currency Code", DisplayNameCountOne: "Testing Currency unit", DisplayNameCountOther: "Testing Currency units", Symbol: "XTS"},
+	"XUA": {DisplayName: "ADB Unit of Account", DisplayNameCountOne: "ADB unit of account", DisplayNameCountOther: "ADB units of account", Symbol: "XUA"},
+	"XXX": {DisplayName: "Unknown Currency", DisplayNameCountOne: "(unknown unit of currency)", DisplayNameCountOther: "(unknown currency)", Symbol: "¤"},
+	"YDD": {DisplayName: "Yemeni Dinar", DisplayNameCountOne: "Yemeni dinar", DisplayNameCountOther: "Yemeni dinars", Symbol: "YDD"},
+	"YER": {DisplayName: "Yemeni Rial", DisplayNameCountOne: "Yemeni rial", DisplayNameCountOther: "Yemeni rials", Symbol: "YER"},
+	"YUD": {DisplayName: "Yugoslavian Hard Dinar (1966–1990)", DisplayNameCountOne: "Yugoslavian hard dinar (1966–1990)", DisplayNameCountOther: "Yugoslavian hard dinars (1966–1990)", Symbol: "YUD"},
+	"YUM": {DisplayName: "Yugoslavian New Dinar (1994–2002)", DisplayNameCountOne: "Yugoslavian new dinar (1994–2002)", DisplayNameCountOther: "Yugoslavian new dinars (1994–2002)", Symbol: "YUM"},
+	"YUN": {DisplayName: "Yugoslavian Convertible Dinar (1990–1992)", DisplayNameCountOne: "Yugoslavian convertible dinar (1990–1992)", DisplayNameCountOther: "Yugoslavian convertible dinars (1990–1992)", Symbol: "YUN"},
+	"YUR": {DisplayName: "Yugoslavian Reformed Dinar (1992–1993)", DisplayNameCountOne: "Yugoslavian reformed dinar (1992–1993)", DisplayNameCountOther: "Yugoslavian reformed dinars (1992–1993)", Symbol: "YUR"},
+	"ZAL": {DisplayName: "South African Rand (financial)", DisplayNameCountOne: "South African rand (financial)", DisplayNameCountOther: "South African rands (financial)", Symbol: "ZAL"},
+	"ZAR": {DisplayName: "South African Rand", DisplayNameCountOne: "South African rand", DisplayNameCountOther: "South African rand", Symbol: "ZAR", SymbolAltNarrow: "R"},
+	"ZMK": {DisplayName: "Zambian Kwacha (1968–2012)", DisplayNameCountOne: "Zambian kwacha (1968–2012)", DisplayNameCountOther: "Zambian kwachas (1968–2012)", Symbol: "ZMK"},
+	"ZMW": {DisplayName: "Zambian Kwacha", DisplayNameCountOne: "Zambian kwacha", DisplayNameCountOther: "Zambian kwachas", Symbol: "ZMW", SymbolAltNarrow: "ZK"},
+	"ZRN": {DisplayName: "Zairean New Zaire (1993–1998)", DisplayNameCountOne: "Zairean new zaire (1993–1998)", DisplayNameCountOther: "Zairean new zaires (1993–1998)", Symbol: "ZRN"},
+	"ZRZ": {DisplayName: "Zairean Zaire (1971–1993)", DisplayNameCountOne: "Zairean zaire (1971–1993)", DisplayNameCountOther: "Zairean zaires (1971–1993)", Symbol: "ZRZ"},
+	"ZWD": {DisplayName: "Zimbabwean Dollar (1980–2008)", DisplayNameCountOne: "Zimbabwean dollar (1980–2008)", DisplayNameCountOther: "Zimbabwean dollars (1980–2008)", Symbol: "ZWD"},
+	"ZWL": {DisplayName: "Zimbabwean Dollar (2009)", DisplayNameCountOne: "Zimbabwean dollar (2009)", DisplayNameCountOther: "Zimbabwean dollars (2009)", Symbol: "ZWL"},
+	"ZWR": {DisplayName: "Zimbabwean Dollar (2008)", DisplayNameCountOne: "Zimbabwean dollar (2008)", DisplayNameCountOther: "Zimbabwean dollars (2008)", Symbol: "ZWR"},
 }
@@ -0,0 +1,4 @@
+// Package en contains CLDR data for regional English locales, like en_IN.
+// Importing it registers those locales with the cldr package. Data for the "en"
+// locale itself is always available from the cldr package.
+package en
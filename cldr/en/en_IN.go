@@ -0,0 +1,15 @@
+package en
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "en_IN",
+		Currencies:      ENIN,
+		Symbols:         cldr.NumberSymbols{Decimal: ".", Group: ",", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "¤#,##,##0.00", Accounting: "¤#,##,##0.00;(¤#,##,##0.00)"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var ENIN = map[string]cldr.Localization{}
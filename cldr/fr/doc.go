@@ -0,0 +1,3 @@
+// Package fr contains CLDR data for French locales. Importing it registers
+// those locales with the cldr package.
+package fr
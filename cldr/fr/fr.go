@@ -0,0 +1,30 @@
+package fr
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "fr",
+		Currencies:      FR,
+		Symbols:         cldr.NumberSymbols{Decimal: ",", Group: "\u202f", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "#,##0.00\u00a0¤", Accounting: "#,##0.00\u00a0¤;(#,##0.00\u00a0¤)"},
+		PluralRules:     cldr.PluralRules{One: "i = 0,1"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var FR = map[string]cldr.Localization{
+	"AUD": {DisplayName: "dollar australien", DisplayNameCountOne: "dollar australien", DisplayNameCountOther: "dollars australiens", Symbol: "$AU", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "réal brésilien", DisplayNameCountOne: "réal brésilien", DisplayNameCountOther: "réals brésiliens", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "dollar canadien", DisplayNameCountOne: "dollar canadien", DisplayNameCountOther: "dollars canadiens", Symbol: "$CA", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "franc suisse", DisplayNameCountOne: "franc suisse", DisplayNameCountOther: "francs suisses", Symbol: "CHF"},
+	"CNY": {DisplayName: "yuan renminbi chinois", DisplayNameCountOne: "yuan renminbi chinois", DisplayNameCountOther: "yuans renminbi chinois", Symbol: "CNY", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "euro", DisplayNameCountOne: "euro", DisplayNameCountOther: "euros", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "livre sterling", DisplayNameCountOne: "livre sterling", DisplayNameCountOther: "livres sterling", Symbol: "£GB", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "roupie indienne", DisplayNameCountOne: "roupie indienne", DisplayNameCountOther: "roupies indiennes", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "yen japonais", DisplayNameCountOne: "yen japonais", DisplayNameCountOther: "yens japonais", Symbol: "JPY", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "peso mexicain", DisplayNameCountOne: "peso mexicain", DisplayNameCountOther: "pesos mexicains", Symbol: "$MX", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "zloty polonais", DisplayNameCountOne: "zloty polonais", DisplayNameCountOther: "zlotys polonais", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "rouble russe", DisplayNameCountOne: "rouble russe", DisplayNameCountOther: "roubles russes", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "dollar des États-Unis", DisplayNameCountOne: "dollar des États-Unis", DisplayNameCountOther: "dollars des États-Unis", Symbol: "$US", SymbolAltNarrow: "$"},
+}
@@ -0,0 +1,3 @@
+// Package hi contains CLDR data for Hindi locales. Importing it registers
+// those locales with the cldr package.
+package hi
@@ -0,0 +1,30 @@
+package hi
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "hi",
+		Currencies:      HI,
+		Symbols:         cldr.NumberSymbols{Decimal: ".", Group: ",", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "¤#,##,##0.00", Accounting: "¤#,##,##0.00"},
+		PluralRules:     cldr.PluralRules{One: "i = 0 or n = 1"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var HI = map[string]cldr.Localization{
+	"AUD": {DisplayName: "ऑस्ट्रेलियाई डॉलर", DisplayNameCountOne: "ऑस्ट्रेलियाई डॉलर", DisplayNameCountOther: "ऑस्ट्रेलियाई डॉलर", Symbol: "A$", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "ब्राज़ीली रियाल", DisplayNameCountOne: "ब्राज़ीली रियाल", DisplayNameCountOther: "ब्राज़ीली रियाल", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "कनाडाई डॉलर", DisplayNameCountOne: "कनाडाई डॉलर", DisplayNameCountOther: "कनाडाई डॉलर", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "स्विस फ़्रैंक", DisplayNameCountOne: "स्विस फ़्रैंक", DisplayNameCountOther: "स्विस फ़्रैंक", Symbol: "CHF"},
+	"CNY": {DisplayName: "चीनी युआन", DisplayNameCountOne: "चीनी युआन", DisplayNameCountOther: "चीनी युआन", Symbol: "CN¥", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "यूरो", DisplayNameCountOne: "यूरो", DisplayNameCountOther: "यूरो", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "ब्रिटिश पाउंड स्टर्लिंग", DisplayNameCountOne: "ब्रिटिश पाउंड स्टर्लिंग", DisplayNameCountOther: "ब्रिटिश पाउंड स्टर्लिंग", Symbol: "£", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "भारतीय रुपया", DisplayNameCountOne: "भारतीय रुपया", DisplayNameCountOther: "भारतीय रुपए", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "जापानी येन", DisplayNameCountOne: "जापानी येन", DisplayNameCountOther: "जापानी येन", Symbol: "JP¥", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "मैक्सिकन पीसो", DisplayNameCountOne: "मैक्सिकन पीसो", DisplayNameCountOther: "मैक्सिकन पीसो", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "पोलिश ज़्लॉटी", DisplayNameCountOne: "पोलिश ज़्लॉटी", DisplayNameCountOther: "पोलिश ज़्लॉटी", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "रूसी रूबल", DisplayNameCountOne: "रूसी रूबल", DisplayNameCountOther: "रूसी रूबल", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "यूएस डॉलर", DisplayNameCountOne: "यूएस डॉलर", DisplayNameCountOther: "यूएस डॉलर", Symbol: "$", SymbolAltNarrow: "$"},
+}
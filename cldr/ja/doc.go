@@ -0,0 +1,3 @@
+// Package ja contains CLDR data for Japanese locales. Importing it registers
+// those locales with the cldr package.
+package ja
@@ -0,0 +1,29 @@
+package ja
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "ja",
+		Currencies:      JA,
+		Symbols:         cldr.NumberSymbols{Decimal: ".", Group: ",", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "¤#,##0.00", Accounting: "¤#,##0.00;(¤#,##0.00)"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var JA = map[string]cldr.Localization{
+	"AUD": {DisplayName: "オーストラリア ドル", DisplayNameCountOther: "オーストラリア ドル", Symbol: "A$", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "ブラジル レアル", DisplayNameCountOther: "ブラジル レアル", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "カナダ ドル", DisplayNameCountOther: "カナダ ドル", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "スイス フラン", DisplayNameCountOther: "スイス フラン", Symbol: "CHF"},
+	"CNY": {DisplayName: "中国人民元", DisplayNameCountOther: "中国人民元", Symbol: "元", SymbolAltNarrow: "￥"},
+	"EUR": {DisplayName: "ユーロ", DisplayNameCountOther: "ユーロ", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "英国ポンド", DisplayNameCountOther: "英国ポンド", Symbol: "£", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "インド ルピー", DisplayNameCountOther: "インド ルピー", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "日本円", DisplayNameCountOther: "円", Symbol: "￥", SymbolAltNarrow: "￥"},
+	"MXN": {DisplayName: "メキシコ ペソ", DisplayNameCountOther: "メキシコ ペソ", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "ポーランド ズウォティ", DisplayNameCountOther: "ポーランド ズウォティ", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "ロシア ルーブル", DisplayNameCountOther: "ロシア ルーブル", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "米ドル", DisplayNameCountOther: "米ドル", Symbol: "$", SymbolAltNarrow: "$"},
+}
@@ -0,0 +1,112 @@
+package cldr
+
+import "strings"
+
+// Locale contains the CLDR data for a single locale. Fields that are missing
+// from a locale are inherited from its parent locale.
+type Locale struct {
+	// ID is the CLDR identifier of the locale, like "de" or "de_CH".
+	ID              string
+	Currencies      map[string]Localization
+	Symbols         NumberSymbols
+	CurrencyFormats CurrencyFormats
+	PluralRules     PluralRules
+
+	plurals compiledRules
+}
+
+var locales = make(map[string]*Locale)
+
+func init() {
+	Register(enLocale)
+}
+
+// Register makes a locale available to Lookup. Only English is registered by
+// default; data for other locales lives in subpackages of this package (for
+// instance, github.com/zenazn/money/cldr/de), which register their locales when
+// imported. Register is not safe to call concurrently with Lookup, and is
+// intended to be called from init functions. It panics if the locale's plural
+// rules are malformed.
+func Register(l *Locale) {
+	plurals, err := l.PluralRules.compile()
+	if err != nil {
+		panic(err.Error())
+	}
+	l.plurals = plurals
+	locales[l.ID] = l
+}
+
+func normalize(locale string) string {
+	return strings.Replace(locale, "-", "_", -1)
+}
+
+// parent returns the parent of the given locale, or "root" if the locale has
+// no parent.
+func parent(locale string) string {
+	if p, ok := parentLocales[locale]; ok {
+		return p
+	}
+	if i := strings.LastIndexByte(locale, '_'); i > 0 {
+		return locale[:i]
+	}
+	return "root"
+}
+
+// chain returns the registered locales in the parent chain of the given
+// locale, starting with the locale itself.
+func chain(locale string) []*Locale {
+	var out []*Locale
+	for id := normalize(locale); id != "root"; id = parent(id) {
+		if loc, ok := locales[id]; ok {
+			out = append(out, loc)
+		}
+	}
+	return out
+}
+
+// Lookup returns the localization of the currency with the given ISO 4217 code
+// in the given locale. Locales may be given either in CLDR form ("de_CH") or in
+// BCP 47 form ("de-CH").
+//
+// Each field is inherited separately through the locale's parent chain (for
+// instance, "de_CH", then "de", then the root locale), skipping locales that
+// have not been registered. The root locale uses the currency code as its
+// symbol and display name. Lookup returns false if no registered locale in the
+// chain has data for the currency.
+func Lookup(locale, code string) (Localization, bool) {
+	var l Localization
+	found := false
+	for _, loc := range chain(locale) {
+		c, ok := loc.Currencies[code]
+		if !ok {
+			continue
+		}
+		found = true
+		l = l.inherit(c)
+	}
+	return l.inherit(Localization{
+		DisplayName: code,
+		Symbol:      code,
+	}), found
+}
+
+// inherit fills in each empty field of the receiver from the given parent.
+func (l Localization) inherit(p Localization) Localization {
+	fill(&l.DisplayName, p.DisplayName)
+	fill(&l.DisplayNameCountZero, p.DisplayNameCountZero)
+	fill(&l.DisplayNameCountOne, p.DisplayNameCountOne)
+	fill(&l.DisplayNameCountTwo, p.DisplayNameCountTwo)
+	fill(&l.DisplayNameCountFew, p.DisplayNameCountFew)
+	fill(&l.DisplayNameCountMany, p.DisplayNameCountMany)
+	fill(&l.DisplayNameCountOther, p.DisplayNameCountOther)
+	fill(&l.Symbol, p.Symbol)
+	fill(&l.SymbolAltNarrow, p.SymbolAltNarrow)
+	fill(&l.SymbolAltVariant, p.SymbolAltVariant)
+	return l
+}
+
+func fill(s *string, p string) {
+	if *s == "" {
+		*s = p
+	}
+}
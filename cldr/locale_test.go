@@ -0,0 +1,126 @@
+package cldr_test
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/cldr"
+	_ "github.com/zenazn/money/cldr/de"
+	_ "github.com/zenazn/money/cldr/en"
+	_ "github.com/zenazn/money/cldr/fr"
+	_ "github.com/zenazn/money/cldr/ja"
+	_ "github.com/zenazn/money/cldr/pl"
+	_ "github.com/zenazn/money/cldr/ru"
+)
+
+var lookupTests = []struct {
+	locale, code string
+	name, symbol string
+	found        bool
+}{
+	{"en", "USD", "US Dollar", "$", true},
+	{"en_US", "USD", "US Dollar", "$", true},
+	{"en-US", "USD", "US Dollar", "$", true},
+	{"en_IN", "USD", "US Dollar", "US$", true},
+	{"de", "EUR", "Euro", "€", true},
+	{"de_CH", "JPY", "Japanischer Yen", "¥", true},
+	{"de-CH", "USD", "US-Dollar", "$", true},
+	{"ja", "JPY", "日本円", "￥", true},
+	{"ja_JP", "USD", "米ドル", "$", true},
+	{"de", "XTS", "XTS", "XTS", false},
+	{"tlh", "USD", "USD", "USD", false},
+}
+
+func TestLookup(t *testing.T) {
+	for i, test := range lookupTests {
+		l, ok := cldr.Lookup(test.locale, test.code)
+		if ok != test.found {
+			t.Errorf("[%d] expected found=%v", i, test.found)
+		}
+		if l.DisplayName != test.name {
+			t.Errorf("[%d] expected name %q got %q", i, test.name, l.DisplayName)
+		}
+		if l.Symbol != test.symbol {
+			t.Errorf("[%d] expected symbol %q got %q", i, test.symbol, l.Symbol)
+		}
+	}
+}
+
+func TestLookupInheritsFields(t *testing.T) {
+	// en_IN → en_001 overrides only the symbol, so the plural display
+	// names must come from en.
+	l, _ := cldr.Lookup("en_IN", "USD")
+	if l.DisplayNameCountOther != "US dollars" {
+		t.Errorf("display name %q", l.DisplayNameCountOther)
+	}
+	if l.SymbolAltNarrow != "$" {
+		t.Errorf("narrow symbol %q", l.SymbolAltNarrow)
+	}
+}
+
+var symbolTests = []struct {
+	locale          string
+	decimal, group  string
+	standard, accts string
+}{
+	{"en", ".", ",", "¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	{"en_IN", ".", ",", "¤#,##,##0.00", "¤#,##,##0.00;(¤#,##,##0.00)"},
+	{"de", ",", ".", "#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	{"de_AT", ",", ".", "#,##0.00\u00a0¤", "#,##0.00\u00a0¤"},
+	{"de_CH", ".", "’", "¤\u00a0#,##0.00;¤-#,##0.00", "¤\u00a0#,##0.00;¤-#,##0.00"},
+	{"ja", ".", ",", "¤#,##0.00", "¤#,##0.00;(¤#,##0.00)"},
+	{"tlh", ".", ",", "¤\u00a0#,##0.00", "¤\u00a0#,##0.00"},
+}
+
+func TestLookupNumbers(t *testing.T) {
+	for i, test := range symbolTests {
+		s := cldr.LookupSymbols(test.locale)
+		if s.Decimal != test.decimal || s.Group != test.group {
+			t.Errorf("[%d] expected %q %q got %+v", i, test.decimal, test.group, s)
+		}
+		if s.MinusSign != "-" || s.PlusSign != "+" {
+			t.Errorf("[%d] signs %+v", i, s)
+		}
+		cf := cldr.LookupCurrencyFormats(test.locale)
+		if cf.Standard != test.standard || cf.Accounting != test.accts {
+			t.Errorf("[%d] expected %q %q got %+v", i, test.standard, test.accts, cf)
+		}
+	}
+}
+
+var displayNameTests = []struct {
+	locale, code, amount string
+	name                 string
+}{
+	{"en", "USD", "1", "US dollar"},
+	{"en", "USD", "1.00", "US dollars"},
+	{"en", "USD", "2", "US dollars"},
+	{"en_IN", "INR", "1", "Indian rupee"},
+	{"pl", "PLN", "1", "złoty polski"},
+	{"pl", "PLN", "2", "złote polskie"},
+	{"pl", "PLN", "5", "złotych polskich"},
+	{"pl", "PLN", "22", "złote polskie"},
+	{"pl", "PLN", "1.50", "złotego polskiego"},
+	{"pl_PL", "EUR", "5", "euro"},
+	{"ru", "RUB", "21", "российский рубль"},
+	{"ru", "RUB", "3", "российских рубля"},
+	{"ru", "RUB", "11", "российских рублей"},
+	{"ru", "RUB", "2.5", "российского рубля"},
+	{"ja", "JPY", "1", "円"},
+	{"fr", "EUR", "1.5", "euro"},
+	{"fr", "EUR", "2", "euros"},
+	{"tlh", "EUR", "2", "EUR"},
+}
+
+func TestDisplayName(t *testing.T) {
+	for i, test := range displayNameTests {
+		name, err := cldr.DisplayName(test.locale, test.code, test.amount)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if name != test.name {
+			t.Errorf("[%d] expected %q got %q", i, test.name, name)
+		}
+	}
+	if _, err := cldr.DisplayName("en", "USD", "1,00"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,212 @@
+package cldr
+
+import (
+	"errors"
+	"strings"
+)
+
+// NumberSymbols contains the symbols a locale uses when formatting numbers in
+// the Latin numbering system.
+type NumberSymbols struct {
+	Decimal   string
+	Group     string
+	PlusSign  string
+	MinusSign string
+}
+
+// CurrencyFormats contains the patterns a locale uses when formatting currency
+// amounts. Patterns use the syntax described in Unicode Technical Standard #35,
+// where "¤" stands for the currency symbol; use ParsePattern to interpret them.
+// Accounting patterns typically render negative amounts in parentheses.
+type CurrencyFormats struct {
+	Standard   string
+	Accounting string
+}
+
+var rootSymbols = NumberSymbols{".", ",", "+", "-"}
+var rootCurrencyFormats = CurrencyFormats{"¤\u00a0#,##0.00", "¤\u00a0#,##0.00"}
+
+// LookupSymbols returns the number symbols of the given locale. Like Lookup,
+// each symbol is inherited separately through the locale's parent chain.
+func LookupSymbols(locale string) NumberSymbols {
+	var s NumberSymbols
+	for _, loc := range chain(locale) {
+		fill(&s.Decimal, loc.Symbols.Decimal)
+		fill(&s.Group, loc.Symbols.Group)
+		fill(&s.PlusSign, loc.Symbols.PlusSign)
+		fill(&s.MinusSign, loc.Symbols.MinusSign)
+	}
+	fill(&s.Decimal, rootSymbols.Decimal)
+	fill(&s.Group, rootSymbols.Group)
+	fill(&s.PlusSign, rootSymbols.PlusSign)
+	fill(&s.MinusSign, rootSymbols.MinusSign)
+	return s
+}
+
+// LookupCurrencyFormats returns the currency formatting patterns of the given
+// locale. Like Lookup, each pattern is inherited separately through the
+// locale's parent chain.
+func LookupCurrencyFormats(locale string) CurrencyFormats {
+	var cf CurrencyFormats
+	for _, loc := range chain(locale) {
+		fill(&cf.Standard, loc.CurrencyFormats.Standard)
+		fill(&cf.Accounting, loc.CurrencyFormats.Accounting)
+	}
+	fill(&cf.Standard, rootCurrencyFormats.Standard)
+	fill(&cf.Accounting, rootCurrencyFormats.Accounting)
+	return cf
+}
+
+// Pattern is a parsed number formatting pattern.
+//
+// Prefixes and suffixes are literal text, except that they may contain the
+// special characters "¤", which stands for the currency symbol, "-", which
+// stands for the locale's minus sign, and "+", which stands for the locale's
+// plus sign.
+type Pattern struct {
+	PositivePrefix string
+	PositiveSuffix string
+	NegativePrefix string
+	NegativeSuffix string
+
+	MinIntegerDigits  int
+	MinFractionDigits int
+	MaxFractionDigits int
+
+	// PrimaryGrouping is the size of the group of integer digits closest
+	// to the decimal separator, and SecondaryGrouping is the size of every
+	// other group. For instance, "#,##0" has groups of 3 and 3, and the
+	// Indian "#,##,##0" has groups of 3 and 2. Both are zero if the
+	// pattern does not group digits.
+	PrimaryGrouping   int
+	SecondaryGrouping int
+}
+
+// ParsePattern parses a number formatting pattern, like "¤#,##0.00" or
+// "#,##0.00 ¤;(#,##0.00 ¤)". If the pattern has no negative subpattern, negative
+// numbers are formatted with the minus sign in front of the positive prefix.
+// Significant digit and scientific notation patterns are not supported.
+func ParsePattern(s string) (Pattern, error) {
+	var p Pattern
+	subs := splitPattern(s)
+	if len(subs) > 2 {
+		return p, errors.New("cldr: pattern has too many subpatterns")
+	}
+
+	prefix, number, suffix, err := splitSubpattern(subs[0])
+	if err != nil {
+		return p, err
+	}
+	p.PositivePrefix = prefix
+	p.PositiveSuffix = suffix
+	p.NegativePrefix = "-" + prefix
+	p.NegativeSuffix = suffix
+
+	if len(subs) == 2 {
+		// Only the affixes of the negative subpattern are significant
+		prefix, _, suffix, err := splitSubpattern(subs[1])
+		if err != nil {
+			return p, err
+		}
+		p.NegativePrefix = prefix
+		p.NegativeSuffix = suffix
+	}
+
+	integer, fraction := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+	if strings.ContainsAny(fraction, ".,") {
+		return p, errors.New("cldr: malformed fraction in pattern")
+	}
+
+	for _, c := range fraction {
+		if c == '0' {
+			p.MinFractionDigits++
+		}
+		p.MaxFractionDigits++
+	}
+	for _, c := range integer {
+		if c == '0' {
+			p.MinIntegerDigits++
+		}
+	}
+
+	last := strings.LastIndexByte(integer, ',')
+	if last >= 0 {
+		p.PrimaryGrouping = len(integer) - last - 1
+		p.SecondaryGrouping = p.PrimaryGrouping
+		if prev := strings.LastIndexByte(integer[:last], ','); prev >= 0 {
+			p.SecondaryGrouping = last - prev - 1
+		}
+		if p.PrimaryGrouping == 0 || p.SecondaryGrouping == 0 {
+			return p, errors.New("cldr: empty digit group in pattern")
+		}
+	}
+
+	return p, nil
+}
+
+// splitPattern splits a pattern into subpatterns at unquoted semicolons.
+func splitPattern(s string) []string {
+	var subs []string
+	quoted := false
+	start := 0
+	for i, c := range s {
+		if c == '\'' {
+			quoted = !quoted
+		} else if c == ';' && !quoted {
+			subs = append(subs, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(subs, s[start:])
+}
+
+// splitSubpattern splits a subpattern into its prefix, number and suffix,
+// removing quotes from the prefix and suffix.
+func splitSubpattern(s string) (prefix, number, suffix string, err error) {
+	var affix [2]strings.Builder
+	var num strings.Builder
+	part := 0 // 0 for the prefix, 1 for the number, 2 for the suffix
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\'' {
+			if part == 1 {
+				part = 2
+			}
+			// Two single quotes represent a literal single quote
+			if i+1 < len(s) && s[i+1] == '\'' {
+				affix[part/2].WriteByte(c)
+				i++
+			} else {
+				quoted = !quoted
+			}
+			continue
+		}
+
+		isNumber := !quoted && strings.IndexByte("#0123456789,.", c) >= 0
+		if c == '@' && !quoted {
+			return "", "", "", errors.New("cldr: significant digit patterns are not supported")
+		}
+		if isNumber && part == 2 {
+			return "", "", "", errors.New("cldr: pattern contains more than one number")
+		} else if isNumber {
+			part = 1
+			num.WriteByte(c)
+		} else {
+			if part == 1 {
+				part = 2
+			}
+			affix[part/2].WriteByte(c)
+		}
+	}
+	if quoted {
+		return "", "", "", errors.New("cldr: unterminated quote in pattern")
+	}
+	if part == 0 {
+		return "", "", "", errors.New("cldr: pattern contains no number")
+	}
+	return affix[0].String(), num.String(), affix[1].String(), nil
+}
@@ -0,0 +1,47 @@
+package cldr
+
+import "testing"
+
+var patternTests = []struct {
+	s string
+	p Pattern
+}{
+	{"¤#,##0.00", Pattern{"¤", "", "-¤", "", 1, 2, 2, 3, 3}},
+	{"¤#,##0.00;(¤#,##0.00)", Pattern{"¤", "", "(¤", ")", 1, 2, 2, 3, 3}},
+	{"#,##0.00\u00a0¤", Pattern{"", "\u00a0¤", "-", "\u00a0¤", 1, 2, 2, 3, 3}},
+	{"¤\u00a0#,##0.00;¤-#,##0.00", Pattern{"¤\u00a0", "", "¤-", "", 1, 2, 2, 3, 3}},
+	{"¤#,##,##0.00", Pattern{"¤", "", "-¤", "", 1, 2, 2, 3, 2}},
+	{"#0.###", Pattern{"", "", "-", "", 1, 0, 3, 0, 0}},
+	{"'#'#,##0.0#", Pattern{"#", "", "-#", "", 1, 1, 2, 3, 3}},
+	{"#,##0 'o''clock'", Pattern{"", " o'clock", "-", " o'clock", 1, 0, 0, 3, 3}},
+}
+
+func TestParsePattern(t *testing.T) {
+	for i, test := range patternTests {
+		p, err := ParsePattern(test.s)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if p != test.p {
+			t.Errorf("[%d] expected %+v got %+v", i, test.p, p)
+		}
+	}
+}
+
+var patternFailureTests = []string{
+	"",
+	"¤",
+	"#,##0.00;#,##0.00;#,##0.00",
+	"#,##0.0,0",
+	"#,##0,.00",
+	"@@#",
+	"#,##0 ¤ 0",
+	"'#,##0",
+}
+
+func TestParsePatternFailures(t *testing.T) {
+	for i, s := range patternFailureTests {
+		if p, err := ParsePattern(s); err == nil {
+			t.Errorf("[%d] unexpectedly passed: %+v", i, p)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package pl contains CLDR data for Polish locales. Importing it registers
+// those locales with the cldr package.
+package pl
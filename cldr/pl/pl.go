@@ -0,0 +1,30 @@
+package pl
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "pl",
+		Currencies:      PL,
+		Symbols:         cldr.NumberSymbols{Decimal: ",", Group: "\u00a0", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "#,##0.00\u00a0¤", Accounting: "#,##0.00\u00a0¤;(#,##0.00\u00a0¤)"},
+		PluralRules:     cldr.PluralRules{One: "i = 1 and v = 0", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14", Many: "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var PL = map[string]cldr.Localization{
+	"AUD": {DisplayName: "dolar australijski", DisplayNameCountOne: "dolar australijski", DisplayNameCountFew: "dolary australijskie", DisplayNameCountMany: "dolarów australijskich", DisplayNameCountOther: "dolara australijskiego", Symbol: "AUD", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "real brazylijski", DisplayNameCountOne: "real brazylijski", DisplayNameCountFew: "reale brazylijskie", DisplayNameCountMany: "reali brazylijskich", DisplayNameCountOther: "reala brazylijskiego", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "dolar kanadyjski", DisplayNameCountOne: "dolar kanadyjski", DisplayNameCountFew: "dolary kanadyjskie", DisplayNameCountMany: "dolarów kanadyjskich", DisplayNameCountOther: "dolara kanadyjskiego", Symbol: "CAD", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "frank szwajcarski", DisplayNameCountOne: "frank szwajcarski", DisplayNameCountFew: "franki szwajcarskie", DisplayNameCountMany: "franków szwajcarskich", DisplayNameCountOther: "franka szwajcarskiego", Symbol: "CHF"},
+	"CNY": {DisplayName: "juan chiński", DisplayNameCountOne: "juan chiński", DisplayNameCountFew: "juany chińskie", DisplayNameCountMany: "juanów chińskich", DisplayNameCountOther: "juana chińskiego", Symbol: "CNY", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "euro", DisplayNameCountOne: "euro", DisplayNameCountFew: "euro", DisplayNameCountMany: "euro", DisplayNameCountOther: "euro", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "funt szterling", DisplayNameCountOne: "funt szterling", DisplayNameCountFew: "funty szterlingi", DisplayNameCountMany: "funtów szterlingów", DisplayNameCountOther: "funta szterlinga", Symbol: "GBP", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "rupia indyjska", DisplayNameCountOne: "rupia indyjska", DisplayNameCountFew: "rupie indyjskie", DisplayNameCountMany: "rupii indyjskich", DisplayNameCountOther: "rupii indyjskiej", Symbol: "INR", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "jen japoński", DisplayNameCountOne: "jen japoński", DisplayNameCountFew: "jeny japońskie", DisplayNameCountMany: "jenów japońskich", DisplayNameCountOther: "jena japońskiego", Symbol: "JPY", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "peso meksykańskie", DisplayNameCountOne: "peso meksykańskie", DisplayNameCountFew: "pesos meksykańskie", DisplayNameCountMany: "pesos meksykańskich", DisplayNameCountOther: "pesos meksykańskiego", Symbol: "MXN", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "złoty polski", DisplayNameCountOne: "złoty polski", DisplayNameCountFew: "złote polskie", DisplayNameCountMany: "złotych polskich", DisplayNameCountOther: "złotego polskiego", Symbol: "zł", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "rubel rosyjski", DisplayNameCountOne: "rubel rosyjski", DisplayNameCountFew: "ruble rosyjskie", DisplayNameCountMany: "rubli rosyjskich", DisplayNameCountOther: "rubla rosyjskiego", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "dolar amerykański", DisplayNameCountOne: "dolar amerykański", DisplayNameCountFew: "dolary amerykańskie", DisplayNameCountMany: "dolarów amerykańskich", DisplayNameCountOther: "dolara amerykańskiego", Symbol: "USD", SymbolAltNarrow: "$"},
+}
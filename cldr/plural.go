@@ -0,0 +1,329 @@
+package cldr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PluralCategory is a CLDR plural category.
+type PluralCategory int
+
+// The CLDR plural categories. Every language uses PluralOther, but most
+// languages only use some of the others.
+const (
+	PluralOther PluralCategory = iota
+	PluralZero
+	PluralOne
+	PluralTwo
+	PluralFew
+	PluralMany
+)
+
+var pluralNames = [...]string{"other", "zero", "one", "two", "few", "many"}
+
+func (c PluralCategory) String() string {
+	if c < 0 || int(c) >= len(pluralNames) {
+		return fmt.Sprintf("PluralCategory(%d)", int(c))
+	}
+	return pluralNames[c]
+}
+
+// PluralRules contains a language's cardinal plural rules, in the syntax
+// described in Unicode Technical Standard #35, but without samples. Each rule
+// describes the numbers that belong to its category, and numbers that match no
+// rule belong to PluralOther. An empty rule matches no numbers.
+type PluralRules struct {
+	Zero string
+	One  string
+	Two  string
+	Few  string
+	Many string
+}
+
+// Operands are the plural operands of a decimal number, as defined in Unicode
+// Technical Standard #35. Because the visible fraction digits of a number
+// affect its plural category, "1" and "1.00" have different operands.
+type Operands struct {
+	// I is the integer part of the absolute value of the number. If the
+	// integer part has more than 18 digits, I contains only the last 18
+	// digits and Large is set.
+	I     uint64
+	Large bool
+	// V is the number of visible fraction digits, and F is the visible
+	// fraction digits as an integer.
+	V int
+	F uint64
+	// W and T are like V and F, but ignore trailing zeros.
+	W int
+	T uint64
+}
+
+const maxOperandDigits = 18
+
+// ParseOperands returns the plural operands of a decimal string like "12",
+// "-1.50" or "0.3". The string may not have more than 18 fraction digits.
+func ParseOperands(s string) (Operands, error) {
+	var o Operands
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+		if fraction == "" {
+			return o, fmt.Errorf("cldr: trailing dot in %q", s)
+		}
+	}
+	if integer == "" {
+		return o, fmt.Errorf("cldr: no integer digits in %q", s)
+	}
+	if len(fraction) > maxOperandDigits {
+		return o, fmt.Errorf("cldr: too many fraction digits in %q", s)
+	}
+
+	for i := 0; i < len(integer); i++ {
+		c := integer[i]
+		if c < '0' || c > '9' {
+			return o, fmt.Errorf("cldr: bad char %q in %q", c, s)
+		}
+		if len(integer)-i > maxOperandDigits {
+			o.Large = o.Large || c != '0'
+			continue
+		}
+		o.I = o.I*10 + uint64(c-'0')
+	}
+
+	o.V = len(fraction)
+	o.W = len(strings.TrimRight(fraction, "0"))
+	for i := 0; i < len(fraction); i++ {
+		c := fraction[i]
+		if c < '0' || c > '9' {
+			return o, fmt.Errorf("cldr: bad char %q in %q", c, s)
+		}
+		o.F = o.F*10 + uint64(c-'0')
+		if i < o.W {
+			o.T = o.T*10 + uint64(c-'0')
+		}
+	}
+	return o, nil
+}
+
+// value returns the value of the given operand modulo mod (or unmodified if mod
+// is zero), and whether the operand is an integer that can be compared.
+func (o Operands) value(operand byte, mod uint64) (uint64, bool) {
+	var v uint64
+	switch operand {
+	case 'n':
+		if o.T != 0 {
+			return 0, false
+		}
+		fallthrough
+	case 'i':
+		if o.Large && (mod == 0 || 1000000000000000000%mod != 0) {
+			return 0, false
+		}
+		v = o.I
+	case 'v':
+		v = uint64(o.V)
+	case 'w':
+		v = uint64(o.W)
+	case 'f':
+		v = o.F
+	case 't':
+		v = o.T
+	}
+	if mod != 0 {
+		v = v % mod
+	}
+	return v, true
+}
+
+type relation struct {
+	operand byte
+	mod     uint64
+	negate  bool
+	ranges  [][2]uint64
+}
+
+func (r relation) match(o Operands) bool {
+	in := false
+	if v, ok := o.value(r.operand, r.mod); ok {
+		for _, rg := range r.ranges {
+			if rg[0] <= v && v <= rg[1] {
+				in = true
+				break
+			}
+		}
+	}
+	return in != r.negate
+}
+
+// rule is a disjunction of conjunctions of relations.
+type rule [][]relation
+
+func (r rule) match(o Operands) bool {
+	for _, and := range r {
+		ok := true
+		for _, rel := range and {
+			if !rel.match(o) {
+				ok = false
+				break
+			}
+		}
+		if ok && len(and) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compiledRules holds the compiled plural rules, indexed by PluralCategory.
+type compiledRules [len(pluralNames)]rule
+
+func (p PluralRules) compile() (compiledRules, error) {
+	var c compiledRules
+	for cat, s := range [...]string{
+		PluralZero: p.Zero,
+		PluralOne:  p.One,
+		PluralTwo:  p.Two,
+		PluralFew:  p.Few,
+		PluralMany: p.Many,
+	} {
+		r, err := parseRule(s)
+		if err != nil {
+			return c, fmt.Errorf("cldr: plural rule %s %q: %v", PluralCategory(cat), s, err)
+		}
+		c[cat] = r
+	}
+	return c, nil
+}
+
+func (c *compiledRules) category(o Operands) PluralCategory {
+	for _, cat := range [...]PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany} {
+		if c[cat].match(o) {
+			return cat
+		}
+	}
+	return PluralOther
+}
+
+// Category returns the plural category of a number with the given operands. It
+// returns an error if any of the rules are malformed.
+func (p PluralRules) Category(o Operands) (PluralCategory, error) {
+	c, err := p.compile()
+	if err != nil {
+		return PluralOther, err
+	}
+	return c.category(o), nil
+}
+
+func parseRule(s string) (rule, error) {
+	var r rule
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return r, nil
+	}
+
+	var and []relation
+	for len(fields) > 0 {
+		rel, rest, err := parseRelation(fields)
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, rel)
+		fields = rest
+		if len(fields) == 0 {
+			break
+		}
+		switch fields[0] {
+		case "and":
+		case "or":
+			r = append(r, and)
+			and = nil
+		default:
+			return nil, fmt.Errorf("unexpected %q", fields[0])
+		}
+		fields = fields[1:]
+		if len(fields) == 0 {
+			return nil, errors.New("unexpected end of rule")
+		}
+	}
+	return append(r, and), nil
+}
+
+func parseRelation(fields []string) (relation, []string, error) {
+	var rel relation
+	if len(fields) < 3 {
+		return rel, nil, errors.New("incomplete relation")
+	}
+
+	if len(fields[0]) != 1 || strings.IndexByte("nivwft", fields[0][0]) < 0 {
+		return rel, nil, fmt.Errorf("unknown operand %q", fields[0])
+	}
+	rel.operand = fields[0][0]
+	fields = fields[1:]
+
+	if fields[0] == "%" {
+		mod, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil || mod == 0 {
+			return rel, nil, fmt.Errorf("bad modulus %q", fields[1])
+		}
+		rel.mod = mod
+		fields = fields[2:]
+		if len(fields) < 2 {
+			return rel, nil, errors.New("incomplete relation")
+		}
+	}
+
+	switch fields[0] {
+	case "=":
+	case "!=":
+		rel.negate = true
+	default:
+		return rel, nil, fmt.Errorf("unknown operator %q", fields[0])
+	}
+
+	for _, rs := range strings.Split(fields[1], ",") {
+		lo, hi := rs, rs
+		if i := strings.Index(rs, ".."); i >= 0 {
+			lo, hi = rs[:i], rs[i+2:]
+		}
+		l, err := strconv.ParseUint(lo, 10, 64)
+		if err != nil {
+			return rel, nil, fmt.Errorf("bad range %q", rs)
+		}
+		h, err := strconv.ParseUint(hi, 10, 64)
+		if err != nil || h < l {
+			return rel, nil, fmt.Errorf("bad range %q", rs)
+		}
+		rel.ranges = append(rel.ranges, [2]uint64{l, h})
+	}
+	return rel, fields[2:], nil
+}
+
+// Plural returns the plural category of a number with the given operands in the
+// given locale. Plural rules are inherited as a whole through the locale's
+// parent chain. In the root locale, every number belongs to PluralOther.
+func Plural(locale string, o Operands) PluralCategory {
+	for _, loc := range chain(locale) {
+		if loc.PluralRules != (PluralRules{}) {
+			return loc.plurals.category(o)
+		}
+	}
+	return PluralOther
+}
+
+// DisplayName returns the display name of the currency with the given ISO 4217
+// code in the given locale, in the plural form appropriate for the given
+// amount. The amount is a decimal string, as it is displayed, so "1" and "1.00"
+// may select different forms. It returns an error if the amount is malformed.
+func DisplayName(locale, code, amount string) (string, error) {
+	o, err := ParseOperands(amount)
+	if err != nil {
+		return "", err
+	}
+	l, _ := Lookup(locale, code)
+	return l.DisplayNameCount(Plural(locale, o)), nil
+}
@@ -0,0 +1,123 @@
+package cldr
+
+import "testing"
+
+var operandTests = []struct {
+	s string
+	o Operands
+}{
+	{"0", Operands{}},
+	{"1", Operands{I: 1}},
+	{"-1", Operands{I: 1}},
+	{"1.0", Operands{I: 1, V: 1}},
+	{"1.50", Operands{I: 1, V: 2, F: 50, W: 1, T: 5}},
+	{"12.345", Operands{I: 12, V: 3, F: 345, W: 3, T: 345}},
+	{"0.0300", Operands{V: 4, F: 300, W: 2, T: 3}},
+	{"1000000000000000000001", Operands{I: 1, Large: true}},
+	{"0001", Operands{I: 1}},
+}
+
+func TestParseOperands(t *testing.T) {
+	for i, test := range operandTests {
+		o, err := ParseOperands(test.s)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if o != test.o {
+			t.Errorf("[%d] expected %+v got %+v", i, test.o, o)
+		}
+	}
+}
+
+var operandFailureTests = []string{
+	"",
+	"-",
+	".5",
+	"5.",
+	"1,5",
+	"1.2.3",
+	"one",
+	"0.1234567890123456789",
+}
+
+func TestParseOperandsFailures(t *testing.T) {
+	for i, s := range operandFailureTests {
+		if o, err := ParseOperands(s); err == nil {
+			t.Errorf("[%d] unexpectedly passed: %+v", i, o)
+		}
+	}
+}
+
+var arabic = PluralRules{
+	Zero: "n = 0",
+	One:  "n = 1",
+	Two:  "n = 2",
+	Few:  "n % 100 = 3..10",
+	Many: "n % 100 = 11..99",
+}
+
+var polish = PluralRules{
+	One:  "i = 1 and v = 0",
+	Few:  "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+	Many: "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14",
+}
+
+var categoryTests = []struct {
+	rules PluralRules
+	n     string
+	c     PluralCategory
+}{
+	{arabic, "0", PluralZero},
+	{arabic, "1", PluralOne},
+	{arabic, "1.0", PluralOne},
+	{arabic, "2", PluralTwo},
+	{arabic, "3", PluralFew},
+	{arabic, "110", PluralFew},
+	{arabic, "11", PluralMany},
+	{arabic, "100", PluralOther},
+	{arabic, "1.5", PluralOther},
+	{polish, "1", PluralOne},
+	{polish, "2", PluralFew},
+	{polish, "22", PluralFew},
+	{polish, "12", PluralMany},
+	{polish, "5", PluralMany},
+	{polish, "0", PluralMany},
+	{polish, "1.5", PluralOther},
+	{polish, "1.00", PluralOther},
+	{polish, "1000000000000000000002", PluralFew},
+	{PluralRules{}, "1", PluralOther},
+}
+
+func TestPluralCategory(t *testing.T) {
+	for i, test := range categoryTests {
+		o, err := ParseOperands(test.n)
+		if err != nil {
+			t.Fatalf("[%d] %v", i, err)
+		}
+		c, err := test.rules.Category(o)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if c != test.c {
+			t.Errorf("[%d] expected %v got %v", i, test.c, c)
+		}
+	}
+}
+
+var ruleFailureTests = []string{
+	"x = 1",
+	"n",
+	"n = ",
+	"n == 1",
+	"n % 0 = 1",
+	"n = 1 and",
+	"n = 1 xor n = 2",
+	"n = 4..2",
+	"n = a",
+}
+
+func TestPluralRuleFailures(t *testing.T) {
+	for i, s := range ruleFailureTests {
+		if _, err := (PluralRules{One: s}).Category(Operands{}); err == nil {
+			t.Errorf("[%d] unexpectedly passed", i)
+		}
+	}
+}
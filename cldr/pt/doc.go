@@ -0,0 +1,3 @@
+// Package pt contains CLDR data for Portuguese locales. Importing it registers
+// those locales with the cldr package.
+package pt
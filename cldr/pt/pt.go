@@ -0,0 +1,30 @@
+package pt
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "pt",
+		Currencies:      PT,
+		Symbols:         cldr.NumberSymbols{Decimal: ",", Group: ".", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "¤\u00a0#,##0.00", Accounting: "¤\u00a0#,##0.00"},
+		PluralRules:     cldr.PluralRules{One: "i = 0..1"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var PT = map[string]cldr.Localization{
+	"AUD": {DisplayName: "Dólar australiano", DisplayNameCountOne: "Dólar australiano", DisplayNameCountOther: "Dólares australianos", Symbol: "AU$", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "Real brasileiro", DisplayNameCountOne: "Real brasileiro", DisplayNameCountOther: "Reais brasileiros", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "Dólar canadense", DisplayNameCountOne: "Dólar canadense", DisplayNameCountOther: "Dólares canadenses", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "Franco suíço", DisplayNameCountOne: "Franco suíço", DisplayNameCountOther: "Francos suíços", Symbol: "CHF"},
+	"CNY": {DisplayName: "Yuan chinês", DisplayNameCountOne: "Yuan chinês", DisplayNameCountOther: "Yuans chineses", Symbol: "CN¥", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "Euro", DisplayNameCountOne: "Euro", DisplayNameCountOther: "Euros", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "Libra esterlina", DisplayNameCountOne: "Libra esterlina", DisplayNameCountOther: "Libras esterlinas", Symbol: "£", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "Rupia indiana", DisplayNameCountOne: "Rupia indiana", DisplayNameCountOther: "Rupias indianas", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "Iene japonês", DisplayNameCountOne: "Iene japonês", DisplayNameCountOther: "Ienes japoneses", Symbol: "JP¥", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "Peso mexicano", DisplayNameCountOne: "Peso mexicano", DisplayNameCountOther: "Pesos mexicanos", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "Zloty polonês", DisplayNameCountOne: "Zloty polonês", DisplayNameCountOther: "Zlotys poloneses", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "Rublo russo", DisplayNameCountOne: "Rublo russo", DisplayNameCountOther: "Rublos russos", Symbol: "RUB", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "Dólar americano", DisplayNameCountOne: "Dólar americano", DisplayNameCountOther: "Dólares americanos", Symbol: "US$", SymbolAltNarrow: "$"},
+}
@@ -0,0 +1,3 @@
+// Package ru contains CLDR data for Russian locales. Importing it registers
+// those locales with the cldr package.
+package ru
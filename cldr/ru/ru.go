@@ -0,0 +1,30 @@
+package ru
+
+import "github.com/zenazn/money/cldr"
+
+func init() {
+	cldr.Register(&cldr.Locale{
+		ID:              "ru",
+		Currencies:      RU,
+		Symbols:         cldr.NumberSymbols{Decimal: ",", Group: "\u00a0", PlusSign: "+", MinusSign: "-"},
+		CurrencyFormats: cldr.CurrencyFormats{Standard: "#,##0.00\u00a0¤", Accounting: "#,##0.00\u00a0¤"},
+		PluralRules:     cldr.PluralRules{One: "v = 0 and i % 10 = 1 and i % 100 != 11", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14", Many: "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14"},
+	})
+}
+
+// Generated from CLDR version 35 (currencies: AUD, BRL, CAD, CHF, CNY, EUR, GBP, INR, JPY, MXN, PLN, RUB, USD)
+var RU = map[string]cldr.Localization{
+	"AUD": {DisplayName: "австралийский доллар", DisplayNameCountOne: "австралийский доллар", DisplayNameCountFew: "австралийских доллара", DisplayNameCountMany: "австралийских долларов", DisplayNameCountOther: "австралийского доллара", Symbol: "A$", SymbolAltNarrow: "$"},
+	"BRL": {DisplayName: "бразильский реал", DisplayNameCountOne: "бразильский реал", DisplayNameCountFew: "бразильских реала", DisplayNameCountMany: "бразильских реалов", DisplayNameCountOther: "бразильского реала", Symbol: "R$", SymbolAltNarrow: "R$"},
+	"CAD": {DisplayName: "канадский доллар", DisplayNameCountOne: "канадский доллар", DisplayNameCountFew: "канадских доллара", DisplayNameCountMany: "канадских долларов", DisplayNameCountOther: "канадского доллара", Symbol: "CA$", SymbolAltNarrow: "$"},
+	"CHF": {DisplayName: "швейцарский франк", DisplayNameCountOne: "швейцарский франк", DisplayNameCountFew: "швейцарских франка", DisplayNameCountMany: "швейцарских франков", DisplayNameCountOther: "швейцарского франка", Symbol: "CHF"},
+	"CNY": {DisplayName: "китайский юань", DisplayNameCountOne: "китайский юань", DisplayNameCountFew: "китайских юаня", DisplayNameCountMany: "китайских юаней", DisplayNameCountOther: "китайского юаня", Symbol: "CN¥", SymbolAltNarrow: "¥"},
+	"EUR": {DisplayName: "евро", DisplayNameCountOne: "евро", DisplayNameCountFew: "евро", DisplayNameCountMany: "евро", DisplayNameCountOther: "евро", Symbol: "€", SymbolAltNarrow: "€"},
+	"GBP": {DisplayName: "британский фунт стерлингов", DisplayNameCountOne: "британский фунт стерлингов", DisplayNameCountFew: "британских фунта стерлингов", DisplayNameCountMany: "британских фунтов стерлингов", DisplayNameCountOther: "британского фунта стерлингов", Symbol: "£", SymbolAltNarrow: "£"},
+	"INR": {DisplayName: "индийская рупия", DisplayNameCountOne: "индийская рупия", DisplayNameCountFew: "индийские рупии", DisplayNameCountMany: "индийских рупий", DisplayNameCountOther: "индийской рупии", Symbol: "₹", SymbolAltNarrow: "₹"},
+	"JPY": {DisplayName: "японская иена", DisplayNameCountOne: "японская иена", DisplayNameCountFew: "японские иены", DisplayNameCountMany: "японских иен", DisplayNameCountOther: "японской иены", Symbol: "¥", SymbolAltNarrow: "¥"},
+	"MXN": {DisplayName: "мексиканский песо", DisplayNameCountOne: "мексиканский песо", DisplayNameCountFew: "мексиканских песо", DisplayNameCountMany: "мексиканских песо", DisplayNameCountOther: "мексиканского песо", Symbol: "MX$", SymbolAltNarrow: "$"},
+	"PLN": {DisplayName: "польский злотый", DisplayNameCountOne: "польский злотый", DisplayNameCountFew: "польских злотых", DisplayNameCountMany: "польских злотых", DisplayNameCountOther: "польского злотого", Symbol: "PLN", SymbolAltNarrow: "zł"},
+	"RUB": {DisplayName: "российский рубль", DisplayNameCountOne: "российский рубль", DisplayNameCountFew: "российских рубля", DisplayNameCountMany: "российских рублей", DisplayNameCountOther: "российского рубля", Symbol: "₽", SymbolAltNarrow: "₽"},
+	"USD": {DisplayName: "доллар США", DisplayNameCountOne: "доллар США", DisplayNameCountFew: "доллара США", DisplayNameCountMany: "долларов США", DisplayNameCountOther: "доллара США", Symbol: "$", SymbolAltNarrow: "$"},
+}
@@ -0,0 +1,128 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+// columnCurrency returns the currency of the values, which must all be
+// compatible, or nil if they are all currencyless zeros.
+func columnCurrency(ms []Money) (currency.Currency, error) {
+	var ccy currency.Currency
+	for _, m := range ms {
+		if err := compat(ccy, m.ccy); err != nil {
+			return nil, err
+		} else if ccy == nil {
+			ccy = m.ccy
+		}
+	}
+	return ccy, nil
+}
+
+// ColumnType returns the precision and scale of the narrowest decimal column,
+// declared as decimal(precision, scale) in Apache Arrow and Parquet, that holds
+// every one of the values exactly. The scale is at least the number of digits
+// in the currency's minor units, and the precision is at least the scale.
+//
+// ColumnType returns an error if the values have incompatible currencies, or
+// if they need a precision greater than decimal.MaxPrecision.
+func ColumnType(ms []Money) (precision, scale int, err error) {
+	ccy, err := columnCurrency(ms)
+	if err != nil {
+		return 0, 0, err
+	} else if ccy == nil {
+		return 1, 0, nil
+	}
+	u := ccy.Units()
+	sf, minor := int(u.MajorUnitScalingFactorExponent), int(u.MinorUnitsInMajorUnitExponent)
+
+	scale = minor
+	for _, m := range ms {
+		for scale < sf {
+			if _, ok := m.amt.Rescale(sf, scale); ok {
+				break
+			}
+			scale++
+		}
+	}
+	precision = scale
+	for _, m := range ms {
+		d, _ := m.amt.Rescale(sf, scale)
+		if p := d.Precision(); p > precision {
+			precision = p
+		}
+	}
+	if precision > decimal.MaxPrecision {
+		return 0, 0, fmt.Errorf("money: values need a precision of %d", precision)
+	}
+	return precision, scale, nil
+}
+
+// EncodeColumn appends the amounts of the values to dst as the 16-byte
+// two's-complement integers, in the given byte order, of an Apache Arrow or
+// Parquet decimal(38, scale) column, and returns the extended buffer. Use
+// ColumnType to pick a scale, or use the currency's
+// MajorUnitScalingFactorExponent to keep every value's full precision.
+//
+// EncodeColumn returns an error if the values have incompatible currencies, or
+// if any of them can't be represented exactly in the column.
+func EncodeColumn(dst []byte, ms []Money, scale int, order decimal.ByteOrder) ([]byte, error) {
+	ccy, err := columnCurrency(ms)
+	if err != nil {
+		return dst, err
+	}
+	var sf int
+	if ccy != nil {
+		sf = int(ccy.Units().MajorUnitScalingFactorExponent)
+	}
+
+	start := len(dst)
+	dst = append(dst, make([]byte, 16*len(ms))...)
+	for i, m := range ms {
+		d, ok := m.amt.Rescale(sf, scale)
+		if !ok || d.Precision() > decimal.MaxPrecision {
+			return dst[:start], fmt.Errorf("money: %s doesn't fit in a decimal(%d, %d) column", m, decimal.MaxPrecision, scale)
+		}
+		if order == decimal.BigEndian {
+			d.WriteBigEndian(dst[start+16*i:])
+		} else {
+			d.Write(dst[start+16*i:])
+		}
+	}
+	return dst, nil
+}
+
+// DecodeColumn appends to dst the values of the given currency whose amounts
+// are encoded in src, in the given byte order, as the 16-byte two's-complement
+// integers of an Apache Arrow or Parquet decimal column with the given scale.
+// It returns the extended slice.
+//
+// DecodeColumn returns an error if the length of src isn't a multiple of 16, or
+// if any of the amounts can't be represented exactly in the currency. It panics
+// if no currency is given.
+func DecodeColumn(dst []Money, src []byte, ccy currency.Currency, scale int, order decimal.ByteOrder) ([]Money, error) {
+	if ccy == nil {
+		panic("money: no currency given")
+	} else if len(src)%16 != 0 {
+		return dst, fmt.Errorf("money: column of %d bytes isn't made of 16-byte values", len(src))
+	}
+	sf := int(ccy.Units().MajorUnitScalingFactorExponent)
+
+	start := len(dst)
+	for ; len(src) > 0; src = src[16:] {
+		var d decimal.Decimal
+		if order == decimal.BigEndian {
+			d = decimal.ReadBigEndian(src)
+		} else {
+			d = decimal.ReadDecimal(src)
+		}
+		amt, ok := d.Rescale(scale, sf)
+		if !ok {
+			return dst[:start], fmt.Errorf("money: %s with scale %d can't be represented in %s", d, scale, ccy.Symbol())
+		}
+		dst = append(dst, Money{amt, ccy})
+	}
+	return dst, nil
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var columnTypeTests = []struct {
+	ms               []Money
+	precision, scale int
+}{
+	{nil, 1, 0},
+	{[]Money{{}}, 1, 0},
+	{[]Money{usd(123456), usd(-5)}, 6, 2},
+	{[]Money{{}, usd(1)}, 2, 2},
+	{[]Money{mustparse("1.005", "USD"), usd(100)}, 4, 3},
+	{[]Money{mustparse("0.000001", "USD")}, 6, 6},
+	{[]Money{mustparse("5", "JPY")}, 1, 0},
+	{[]Money{mustparse("100000000000000000000000000000000", "USD")}, 35, 2},
+}
+
+func TestColumnType(t *testing.T) {
+	for i, test := range columnTypeTests {
+		p, s, err := ColumnType(test.ms)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if p != test.precision || s != test.scale {
+			t.Errorf("[%d] expected decimal(%d, %d) got decimal(%d, %d)", i, test.precision, test.scale, p, s)
+		}
+	}
+
+	if p, s, err := ColumnType([]Money{usd(1), mustparse("1", "EUR")}); err == nil {
+		t.Errorf("expected error for mixed currencies got decimal(%d, %d)", p, s)
+	}
+	if p, s, err := ColumnType([]Money{mustparse("170141183460469231731687303715884.105727", "USD")}); err == nil {
+		t.Errorf("expected error for too much precision got decimal(%d, %d)", p, s)
+	}
+}
+
+func TestEncodeColumn(t *testing.T) {
+	ms := []Money{usd(1234), usd(-1), {}}
+	columns := []struct {
+		order decimal.ByteOrder
+		enc   string
+	}{
+		{decimal.BigEndian, "000000000000000000000000000004d2" + "ffffffffffffffffffffffffffffffff" + "00000000000000000000000000000000"},
+		{decimal.LittleEndian, "d2040000000000000000000000000000" + "ffffffffffffffffffffffffffffffff" + "00000000000000000000000000000000"},
+	}
+	for _, c := range columns {
+		buf, err := EncodeColumn([]byte("x"), ms, 2, c.order)
+		if err != nil {
+			t.Errorf("%d: unexpected error %v", c.order, err)
+		} else if s := hex.EncodeToString(buf); s != "78"+c.enc {
+			t.Errorf("%d: expected %q got %q", c.order, "78"+c.enc, s)
+		}
+
+		out, err := DecodeColumn(nil, buf[1:], currency.USD, 2, c.order)
+		if err != nil {
+			t.Errorf("%d: unexpected error %v", c.order, err)
+		} else if len(out) != len(ms) {
+			t.Errorf("%d: expected %v got %v", c.order, ms, out)
+		} else {
+			for i := range ms {
+				if !out[i].Eq(ms[i]) || out[i].Currency() != currency.USD {
+					t.Errorf("%d: [%d] expected %v got %v", c.order, i, ms[i], out[i])
+				}
+			}
+		}
+	}
+}
+
+func TestEncodeColumnFailures(t *testing.T) {
+	tests := []struct {
+		ms    []Money
+		scale int
+	}{
+		{[]Money{usd(1), usd(1)}, 1},
+		{[]Money{usd(1), mustparse("1", "EUR")}, 2},
+		{[]Money{mustparse("170141183460469231731687303715884.105727", "USD")}, 6},
+		{[]Money{mustparse("170141183460469231731687303715884", "USD")}, 7},
+	}
+	for i, test := range tests {
+		if buf, err := EncodeColumn([]byte("x"), test.ms, test.scale, decimal.BigEndian); err == nil {
+			t.Errorf("[%d] expected error got %x", i, buf)
+		} else if string(buf) != "x" {
+			t.Errorf("[%d] expected buffer to be unchanged got %x", i, buf)
+		}
+	}
+}
+
+func TestDecodeColumnFailures(t *testing.T) {
+	if out, err := DecodeColumn(nil, make([]byte, 17), currency.USD, 2, decimal.BigEndian); err == nil {
+		t.Errorf("expected error got %v", out)
+	}
+
+	// USD 0.0000001 is too precise for a Money
+	buf := make([]byte, 32)
+	buf[31] = 1
+	if out, err := DecodeColumn([]Money{usd(1)}, buf, currency.USD, 7, decimal.BigEndian); err == nil {
+		t.Errorf("expected error got %v", out)
+	} else if len(out) != 1 {
+		t.Errorf("expected slice to be unchanged got %v", out)
+	}
+}
@@ -1,6 +1,12 @@
 package money
 
-// LtErr returns true if the argument is less than the receiver, or an error if
+import (
+	"sort"
+
+	"github.com/zenazn/money/currency"
+)
+
+// LtErr returns true if the receiver is less than the argument, or an error if
 // the two are not comparable (i.e., they have different currencies).
 func (m Money) LtErr(o Money) (bool, error) {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -9,7 +15,7 @@ func (m Money) LtErr(o Money) (bool, error) {
 	return m.amt.Lt(o.amt), nil
 }
 
-// Lt returns true if the argument is less than the receiver, or panics if the
+// Lt returns true if the receiver is less than the argument, or panics if the
 // two are not comparable (i.e., they have different currencies).
 func (m Money) Lt(o Money) bool {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -18,7 +24,7 @@ func (m Money) Lt(o Money) bool {
 	return m.amt.Lt(o.amt)
 }
 
-// LteErr returns true if the argument is less than or equal to the receiver, or
+// LteErr returns true if the receiver is less than or equal to the argument, or
 // an error if the two are not comparable (i.e., they have different
 // currencies).
 func (m Money) LteErr(o Money) (bool, error) {
@@ -28,7 +34,7 @@ func (m Money) LteErr(o Money) (bool, error) {
 	return m.amt.Lte(o.amt), nil
 }
 
-// Lte returns true if the argument is less than or equal to the receiver, or
+// Lte returns true if the receiver is less than or equal to the argument, or
 // panics if the two are not comparable (i.e., they have different currencies).
 func (m Money) Lte(o Money) bool {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -55,7 +61,7 @@ func (m Money) Eq(o Money) bool {
 	return m.amt.Eq(o.amt)
 }
 
-// GteErr returns true if the argument is greater than or equal to the receiver,
+// GteErr returns true if the receiver is greater than or equal to the argument,
 // or an error if the two are not comparable (i.e., they have different
 // currencies).
 func (m Money) GteErr(o Money) (bool, error) {
@@ -65,7 +71,7 @@ func (m Money) GteErr(o Money) (bool, error) {
 	return m.amt.Gte(o.amt), nil
 }
 
-// Gte returns true if the argument is greater than or equal to the receiver, or
+// Gte returns true if the receiver is greater than or equal to the argument, or
 // panics if the two are not comparable (i.e., they have different currencies).
 func (m Money) Gte(o Money) bool {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -74,7 +80,7 @@ func (m Money) Gte(o Money) bool {
 	return m.amt.Gte(o.amt)
 }
 
-// GtErr returns true if the argument is greater than the receiver, or an error
+// GtErr returns true if the receiver is greater than the argument, or an error
 // if the two are not comparable (i.e., they have different currencies).
 func (m Money) GtErr(o Money) (bool, error) {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -83,7 +89,7 @@ func (m Money) GtErr(o Money) (bool, error) {
 	return m.amt.Gt(o.amt), nil
 }
 
-// Gt returns true if the argument is greater than the receiver, or panics if
+// Gt returns true if the receiver is greater than the argument, or panics if
 // the two are not comparable (i.e., they have different currencies).
 func (m Money) Gt(o Money) bool {
 	if err := compat(m.ccy, o.ccy); err != nil {
@@ -91,3 +97,42 @@ func (m Money) Gt(o Money) bool {
 	}
 	return m.amt.Gt(o.amt)
 }
+
+// Cmp compares the receiver and argument, and returns -1 if the receiver is
+// less than the argument, 0 if they are equal, and 1 if the receiver is greater
+// than the argument. It returns an error if the two are not comparable (i.e.,
+// they have different currencies).
+func (m Money) Cmp(o Money) (int, error) {
+	if err := compat(m.ccy, o.ccy); err != nil {
+		return 0, err
+	}
+	return m.amt.Cmp(o.amt), nil
+}
+
+// Less returns a function that reports whether the value at index i of the
+// given slice is less than the value at index j, suitable for use with
+// sort.Slice. The returned function panics if the two values are not comparable
+// (i.e., they have different currencies); use SortByAmount to sort values that
+// may not be comparable.
+func Less(ms []Money) func(i, j int) bool {
+	return func(i, j int) bool {
+		return ms[i].Lt(ms[j])
+	}
+}
+
+// SortByAmount sorts the given values in increasing order, keeping equal values
+// in their original order. It returns an error, and leaves the slice unchanged,
+// if the values are not all comparable (i.e., they have different currencies).
+func SortByAmount(ms []Money) error {
+	var ccy currency.Currency
+	for _, m := range ms {
+		if err := compat(ccy, m.ccy); err != nil {
+			return err
+		}
+		if ccy == nil {
+			ccy = m.ccy
+		}
+	}
+	sort.SliceStable(ms, Less(ms))
+	return nil
+}
@@ -74,3 +74,46 @@ func TestComparisonErrors(t *testing.T) {
 		t.Error("GtErr expected error")
 	}
 }
+
+var cmpTests = []struct {
+	a, b Money
+	cmp  int
+}{
+	{onecad, tencad, -1},
+	{tencad, onecad, 1},
+	{onecad, onecad, 0},
+	{Money{}, onecad, -1},
+	{onecad.Neg(), Money{}, -1},
+}
+
+func TestCmp(t *testing.T) {
+	for i, test := range cmpTests {
+		if r, err := test.a.Cmp(test.b); err != nil || r != test.cmp {
+			t.Errorf("[%d] expected %d got %d (%v)", i, test.cmp, r, err)
+		}
+	}
+	if _, err := onecad.Cmp(usd(1)); err == nil {
+		t.Error("Cmp expected error")
+	}
+}
+
+func TestSortByAmount(t *testing.T) {
+	ms := []Money{usd(300), usd(-5), {}, usd(100), usd(-5)}
+	if err := SortByAmount(ms); err != nil {
+		t.Fatal(err)
+	}
+	expected := []Money{usd(-5), usd(-5), {}, usd(100), usd(300)}
+	for i := range ms {
+		if ms[i] != expected[i] {
+			t.Errorf("[%d] expected %s got %s", i, expected[i], ms[i])
+		}
+	}
+
+	mixed := []Money{usd(300), {}, cad(100)}
+	if err := SortByAmount(mixed); err == nil {
+		t.Error("SortByAmount expected error")
+	}
+	if mixed[0] != usd(300) {
+		t.Errorf("expected slice to be unchanged, got %v", mixed)
+	}
+}
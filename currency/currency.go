@@ -65,10 +65,75 @@ var _ Currency = iso(0)
 var NoSuchCurrency = errors.New("currency: no such ISO currency")
 
 // FromISOSymbol returns a Currency object corresponding to the given ISO
-// currency code, or NoSuchCurrency if no such currency exists.
+// currency code, or NoSuchCurrency if no such currency exists. Besides current
+// currencies, it recognizes the withdrawn currencies BYR, MRO, STD and VEF.
 func FromISOSymbol(s string) (Currency, error) {
-	if c, ok := symbolToCurrency[s]; ok {
-		return c, nil
+	if len(s) == 3 {
+		code := uint32(s[0])<<16 | uint32(s[1])<<8 | uint32(s[2])
+		for i := symbolSlot(code); symbols[i].ccy != nil; i = (i + 1) % len(symbols) {
+			if symbols[i].code == code {
+				return symbols[i].ccy, nil
+			}
+		}
 	}
 	return nil, NoSuchCurrency
 }
+
+// ISONumber returns the ISO 4217 numeric code of the currency, and whether it
+// has one. Only the ISO currencies defined by this package have numeric codes.
+func ISONumber(c Currency) (int, bool) {
+	switch c := c.(type) {
+	case iso:
+		return int(c), true
+	case historical:
+		return int(c.number), true
+	}
+	return 0, false
+}
+
+// FromISONumber returns a Currency object corresponding to the given ISO 4217
+// numeric code, or NoSuchCurrency if no such currency exists. Like
+// FromISOSymbol, it recognizes the withdrawn currencies BYR, MRO, STD and VEF.
+func FromISONumber(n int) (Currency, error) {
+	if n >= 0 && n < len(numberToMinor) && numberToMinor[n] != 0xff {
+		return iso(n), nil
+	}
+	for _, c := range historicalSymbols {
+		if h := c.(historical); int(h.number) == n {
+			return c, nil
+		}
+	}
+	return nil, NoSuchCurrency
+}
+
+// symbols is an open-addressed hash table of every currency recognized by
+// FromISOSymbol, keyed by its three-letter code packed into an integer. It is
+// considerably faster than a map keyed by strings, and holds the currencies as
+// interface values up front so that looking one up doesn't allocate.
+var symbols [512]struct {
+	code uint32
+	ccy  Currency
+}
+
+// symbolSlot returns the preferred index of a code in symbols.
+func symbolSlot(code uint32) int {
+	return int((code * 2654435761) >> 23)
+}
+
+func addSymbol(s string, c Currency) {
+	code := uint32(s[0])<<16 | uint32(s[1])<<8 | uint32(s[2])
+	i := symbolSlot(code)
+	for symbols[i].ccy != nil {
+		i = (i + 1) % len(symbols)
+	}
+	symbols[i].code, symbols[i].ccy = code, c
+}
+
+func init() {
+	for s, c := range symbolToCurrency {
+		addSymbol(s, c)
+	}
+	for s, c := range historicalSymbols {
+		addSymbol(s, c)
+	}
+}
@@ -28,7 +28,52 @@ func TestFromISO(t *testing.T) {
 	if c, err := FromISOSymbol("EUR"); err != nil || c != EUR {
 		t.Errorf("EUR is %#v, not %#v?! %v", c, EUR, err)
 	}
+	if c, err := FromISOSymbol("VEF"); err != nil || c != VEF || c.Units().MinorUnitsInMajorUnitExponent != 2 {
+		t.Errorf("VEF is %#v, not %#v?! %v", c, VEF, err)
+	}
 	if c, err := FromISOSymbol("bitcoin"); err == nil || c != nil {
 		t.Errorf("bitcoin is not a currency! %v, %#v", err, c)
 	}
 }
+
+func TestFromISOAll(t *testing.T) {
+	for s, i := range symbolToCurrency {
+		if c, err := FromISOSymbol(s); err != nil || c != i {
+			t.Errorf("%s is %#v, not %#v?! %v", s, c, i, err)
+		}
+	}
+	for s, h := range historicalSymbols {
+		if c, err := FromISOSymbol(s); err != nil || c != h {
+			t.Errorf("%s is %#v, not %#v?! %v", s, c, h, err)
+		}
+	}
+	for _, s := range []string{"", "US", "usd", "USDX", "ABC", "\x00\x00\x00"} {
+		if c, err := FromISOSymbol(s); err == nil || c != nil {
+			t.Errorf("%q is not a currency! %v, %#v", s, err, c)
+		}
+	}
+}
+
+func TestISONumber(t *testing.T) {
+	for _, c := range []Currency{USD, EUR, JPY, XXX, BYR, VEF} {
+		n, ok := ISONumber(c)
+		if !ok {
+			t.Errorf("%s has no number?!", c.Symbol())
+			continue
+		}
+		if d, err := FromISONumber(n); err != nil || d != c {
+			t.Errorf("%d is %#v, not %#v?! %v", n, d, c, err)
+		}
+	}
+	if n, ok := ISONumber(USD); n != 840 || !ok {
+		t.Errorf("USD is %d?!", n)
+	}
+	if n, ok := ISONumber(MRO); n != 478 || !ok {
+		t.Errorf("MRO is %d?!", n)
+	}
+	for _, n := range []int{-1, 0, 1, 1000, 1 << 20} {
+		if c, err := FromISONumber(n); err == nil || c != nil {
+			t.Errorf("%d is not a currency! %v, %#v", n, err, c)
+		}
+	}
+}
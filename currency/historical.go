@@ -0,0 +1,32 @@
+package currency
+
+// historical is an ISO 4217 currency that has been withdrawn from use.
+type historical struct {
+	symbol string
+	number uint16
+	minor  uint8
+}
+
+func (h historical) Symbol() string {
+	return h.symbol
+}
+
+func (h historical) Units() Units {
+	return Units{h.minor, 6}
+}
+
+// Withdrawn ISO 4217 currencies that were replaced by redenominated successors.
+// They are no longer in use, but amounts in them appear in historical records.
+var (
+	BYR Currency = historical{"BYR", 974, 0}
+	MRO Currency = historical{"MRO", 478, 2}
+	STD Currency = historical{"STD", 678, 2}
+	VEF Currency = historical{"VEF", 937, 2}
+)
+
+var historicalSymbols = map[string]Currency{
+	"BYR": BYR,
+	"MRO": MRO,
+	"STD": STD,
+	"VEF": VEF,
+}
@@ -0,0 +1,180 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zenazn/money/decimal"
+)
+
+// DayCount is a day count convention, which determines the fraction of a year
+// between two dates for the purpose of accruing interest. Conventions follow
+// the definitions in section 4.16 of the 2006 ISDA Definitions.
+//
+// Only the calendar dates of times are significant: their time of day is
+// ignored, as are differences in their time zones.
+type DayCount int
+
+const (
+	// Act360 divides the actual number of days by 360.
+	Act360 DayCount = iota
+	// Act365Fixed divides the actual number of days by 365.
+	Act365Fixed
+	// ActActISDA divides the actual number of days that fall in leap
+	// years by 366, and the rest by 365.
+	ActActISDA
+	// Thirty360US is the 30/360 convention used in the US (also known as
+	// 30/360 SIA), which treats the last day of February like the 30th.
+	Thirty360US
+	// Thirty360Bond is the 30/360 bond basis convention (ISDA 4.16(f)),
+	// which only treats the 31st of the end month like the 30th if the
+	// start date is the 30th or 31st.
+	Thirty360Bond
+	// Thirty360European is the 30/360 convention used in Europe (also
+	// known as 30E/360 or the Eurobond basis, ISDA 4.16(g)), which treats
+	// the 31st of any month like the 30th.
+	Thirty360European
+)
+
+var dayCountNames = [...]string{
+	"ACT/360", "ACT/365F", "ACT/ACT ISDA", "30/360 US", "30/360", "30E/360",
+}
+
+func (c DayCount) String() string {
+	if c < 0 || int(c) >= len(dayCountNames) {
+		return fmt.Sprintf("DayCount(%d)", int(c))
+	}
+	return dayCountNames[c]
+}
+
+// civil returns the number of days from the Unix epoch to the calendar date of
+// the given time.
+func civil(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func lastOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && t.AddDate(0, 0, 1).Month() == time.March
+}
+
+// fraction returns the fraction of a year between the two dates as a numerator
+// and denominator, which are negative if to is before from.
+func (c DayCount) fraction(from, to time.Time) (num, den int64, err error) {
+	if civil(to) < civil(from) {
+		num, den, err = c.fraction(to, from)
+		return -num, den, err
+	}
+
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	switch c {
+	case Act360:
+		return civil(to) - civil(from), 360, nil
+	case Act365Fixed:
+		return civil(to) - civil(from), 365, nil
+	case ActActISDA:
+		// Count the days in leap and non-leap years separately
+		var leap, common int64
+		for y := y1; y <= y2; y++ {
+			start := civil(time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC))
+			end := civil(time.Date(y+1, 1, 1, 0, 0, 0, 0, time.UTC))
+			if y == y1 {
+				start = civil(from)
+			}
+			if y == y2 {
+				end = civil(to)
+			}
+			if isLeap(y) {
+				leap += end - start
+			} else {
+				common += end - start
+			}
+		}
+		return leap*365 + common*366, 365 * 366, nil
+	case Thirty360US:
+		if lastOfFebruary(from) && lastOfFebruary(to) {
+			d2 = 30
+		}
+		if lastOfFebruary(from) {
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case Thirty360Bond:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+	case Thirty360European:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	default:
+		return 0, 0, fmt.Errorf("money: unknown day count convention %d", int(c))
+	}
+	days := 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+	return int64(days), 360, nil
+}
+
+// YearFraction returns the fraction of a year between the two dates, rounded to
+// the nearest part per million using Bankers Rounding. The fraction is negative
+// if to is before from. YearFraction returns an error if the convention is
+// unknown.
+func (c DayCount) YearFraction(from, to time.Time) (decimal.Rate, error) {
+	num, den, err := c.fraction(from, to)
+	if err != nil {
+		return decimal.Rate{}, err
+	}
+	n := num * 1000000
+	neg := n < 0
+	if neg {
+		n = -n
+	}
+	ppm, r := n/den, n%den
+	if 2*r > den || (2*r == den && ppm%2 == 1) {
+		ppm++
+	}
+	if neg {
+		ppm = -ppm
+	}
+	return decimal.NewRate(ppm), nil
+}
+
+// AccrueInterest returns the simple interest that accrues on the principal
+// between the two dates at the given annual rate, using the given day count
+// convention.
+//
+// The interest is computed exactly and rounded once, to the precision of the
+// principal's currency, using Bankers Rounding; in particular, it isn't
+// computed from a year fraction rounded to a Rate. Use Round to round the
+// interest to minor units. AccrueInterest returns an error if to is before
+// from, or if the convention is unknown.
+func AccrueInterest(principal Money, annualRate decimal.Rate, from, to time.Time, c DayCount) (Money, error) {
+	if civil(to) < civil(from) {
+		return Money{}, errors.New("money: interest accrual period ends before it starts")
+	}
+	num, den, err := c.fraction(from, to)
+	if err != nil {
+		return Money{}, err
+	}
+	// The interest is principal * (ppm/1000000) * (num/den)
+	one := decimal.FromI64(1)
+	n := decimal.FromI64(annualRate.PPM()).MulDiv(decimal.FromI64(num), one, 0, decimal.RoundDown)
+	d := decimal.FromI64(den * 1000000)
+	return Money{principal.amt.MulDiv(n, d, 0, decimal.RoundHalfEven), principal.ccy}, nil
+}
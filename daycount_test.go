@@ -0,0 +1,113 @@
+package money
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenazn/money/decimal"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+var yearFractionTests = []struct {
+	c        DayCount
+	from, to time.Time
+	ppm      int64
+}{
+	// ISDA's example period, from 1 November 2003 to 1 May 2004
+	{Act360, date(2003, 11, 1), date(2004, 5, 1), 505556},
+	{Act365Fixed, date(2003, 11, 1), date(2004, 5, 1), 498630},
+	{ActActISDA, date(2003, 11, 1), date(2004, 5, 1), 497724},
+	{Thirty360US, date(2003, 11, 1), date(2004, 5, 1), 500000},
+	{ActActISDA, date(2004, 1, 1), date(2005, 1, 1), 1000000},
+	{ActActISDA, date(2003, 7, 1), date(2005, 7, 1), 2000000},
+	{ActActISDA, date(2004, 5, 1), date(2003, 11, 1), -497724},
+
+	{Thirty360US, date(2007, 1, 15), date(2007, 1, 30), 41667},
+	{Thirty360Bond, date(2007, 1, 15), date(2007, 1, 30), 41667},
+	{Thirty360European, date(2007, 1, 15), date(2007, 1, 30), 41667},
+
+	// 30, 33 and 32 days
+	{Thirty360US, date(2007, 2, 28), date(2007, 3, 31), 83333},
+	{Thirty360Bond, date(2007, 2, 28), date(2007, 3, 31), 91667},
+	{Thirty360European, date(2007, 2, 28), date(2007, 3, 31), 88889},
+
+	// 179 days
+	{Thirty360US, date(2007, 8, 31), date(2008, 2, 29), 497222},
+	{Thirty360Bond, date(2007, 8, 31), date(2008, 2, 29), 497222},
+	{Thirty360European, date(2007, 8, 31), date(2008, 2, 29), 497222},
+
+	// 360, 359 and 359 days
+	{Thirty360US, date(2008, 2, 29), date(2009, 2, 28), 1000000},
+	{Thirty360Bond, date(2008, 2, 29), date(2009, 2, 28), 997222},
+	{Thirty360European, date(2008, 2, 29), date(2009, 2, 28), 997222},
+
+	// 30, 30 and 29 days
+	{Thirty360US, date(2007, 3, 30), date(2007, 4, 30), 83333},
+	{Thirty360US, date(2007, 3, 31), date(2007, 4, 30), 83333},
+	{Thirty360Bond, date(2007, 3, 30), date(2007, 5, 31), 166667},
+	{Thirty360Bond, date(2007, 3, 29), date(2007, 5, 31), 172222},
+	{Thirty360European, date(2007, 3, 29), date(2007, 5, 31), 169444},
+}
+
+func TestYearFraction(t *testing.T) {
+	for i, test := range yearFractionTests {
+		if r, err := test.c.YearFraction(test.from, test.to); err != nil {
+			t.Errorf("[%d] %s unexpected error: %v", i, test.c, err)
+		} else if r != decimal.NewRate(test.ppm) {
+			t.Errorf("[%d] %s expected %d got %d", i, test.c, test.ppm, r.PPM())
+		}
+	}
+	if r, err := DayCount(99).YearFraction(date(2019, 1, 1), date(2019, 2, 1)); err == nil {
+		t.Errorf("expected error for unknown convention got %d", r.PPM())
+	}
+}
+
+func TestYearFractionIgnoresTime(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	from := time.Date(2019, 1, 1, 23, 0, 0, 0, ny)
+	to := time.Date(2019, 1, 2, 1, 0, 0, 0, time.UTC)
+	if r, err := Act360.YearFraction(from, to); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if r != decimal.NewRate(2778) {
+		t.Errorf("expected 2778 got %d", r.PPM())
+	}
+}
+
+var accrueTests = []struct {
+	p        Money
+	rate     int64
+	from, to time.Time
+	c        DayCount
+	interest string
+}{
+	{mustparse("1000000", "USD"), 50000, date(2019, 1, 1), date(2019, 1, 31), Act360, "USD 4166.666667"},
+	{mustparse("1000000", "USD"), 50000, date(2019, 1, 1), date(2019, 1, 31), Act365Fixed, "USD 4109.589041"},
+	{mustparse("1000000", "USD"), 50000, date(2019, 1, 1), date(2019, 1, 31), Thirty360US, "USD 4166.666667"},
+	{mustparse("10000000", "EUR"), 10000, date(2003, 11, 1), date(2004, 5, 1), ActActISDA, "EUR 49772.438057"},
+	{mustparse("100", "JPY"), 50000, date(2019, 1, 1), date(2020, 1, 1), Act365Fixed, "JPY 5"},
+	{mustparse("100", "JPY"), 50000, date(2019, 1, 1), date(2019, 1, 1), Act365Fixed, "JPY 0"},
+}
+
+func TestAccrueInterest(t *testing.T) {
+	for i, test := range accrueTests {
+		r, err := AccrueInterest(test.p, decimal.NewRate(test.rate), test.from, test.to, test.c)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if r.String() != test.interest {
+			t.Errorf("[%d] expected %q got %q", i, test.interest, r)
+		}
+	}
+
+	if _, err := AccrueInterest(usd(100), decimal.NewRate(1), date(2019, 1, 2), date(2019, 1, 1), Act360); err == nil {
+		t.Error("expected error accruing backwards")
+	}
+	if _, err := AccrueInterest(usd(100), decimal.NewRate(1), date(2019, 1, 1), date(2019, 1, 2), DayCount(17)); err == nil {
+		t.Error("expected error for unknown convention")
+	}
+}
@@ -0,0 +1,120 @@
+package decimal
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Allocate splits the Decimal into parts proportional to the given weights, each
+// a multiple of 10**exp, which sum exactly to the Decimal. Each part is first
+// rounded towards zero, and the multiples of 10**exp left over are then handed
+// out one at a time to the parts with the largest remainders, with ties going
+// to the earliest part. Allocate panics if the Decimal is not a multiple of
+// 10**exp, if any weight is negative, or if the weights sum to zero.
+func (d Decimal) Allocate(weights []Decimal, exp int) []Decimal {
+	counts := make([]int64, len(weights))
+	for i := range counts {
+		counts[i] = 1
+	}
+	parts, extra, unit := d.allocate(weights, counts, exp)
+	out := make([]Decimal, len(weights))
+	for i, p := range parts {
+		if extra[i] > 0 {
+			p.Add(p, unit)
+		}
+		// Every part is no larger in magnitude than the Decimal
+		out[i], _ = fromBig(p)
+	}
+	return out
+}
+
+// AllocateRuns splits the Decimal exactly like Allocate would if each of the
+// given weights were repeated the given number of times, without listing every
+// part, which makes it suitable for splits into millions of parts. Each of the
+// counts[i] parts with weight weights[i] is parts[i], and the first extra[i] of
+// them get another 10**exp, with the sign of the Decimal, on top. AllocateRuns
+// panics if the weights and counts have different lengths, if any count is
+// negative, or in the cases that Allocate does.
+func (d Decimal) AllocateRuns(weights []Decimal, counts []int64, exp int) (parts []Decimal, extra []int64) {
+	if len(weights) != len(counts) {
+		panic("decimal: allocate: weights and counts have different lengths")
+	}
+	ps, extra, _ := d.allocate(weights, counts, exp)
+	parts = make([]Decimal, len(ps))
+	for i, p := range ps {
+		// Every part is no larger in magnitude than the Decimal
+		parts[i], _ = fromBig(p)
+	}
+	return parts, extra
+}
+
+// allocate returns the rounded down part of each of the parts in each run, the
+// number of parts in each run that get another unit, and that unit, all with
+// the sign of the Decimal.
+func (d Decimal) allocate(weights []Decimal, counts []int64, exp int) (parts []*big.Int, extra []int64, unit *big.Int) {
+	if exp < 0 {
+		exp = 0
+	}
+	unit = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+	n, r := new(big.Int).QuoRem(d.big(), unit, new(big.Int))
+	if r.Sign() != 0 {
+		panic("decimal: allocate: value is not a multiple of the unit")
+	}
+	neg := n.Sign() < 0
+	n.Abs(n)
+
+	total := new(big.Int)
+	for i, w := range weights {
+		if w.Sign() < 0 {
+			panic("decimal: allocate: negative weight")
+		} else if counts[i] < 0 {
+			panic("decimal: allocate: negative count")
+		}
+		total.Add(total, new(big.Int).Mul(w.big(), big.NewInt(counts[i])))
+	}
+	if total.Sign() == 0 {
+		panic("decimal: allocate: weights sum to zero")
+	}
+
+	parts = make([]*big.Int, len(weights))
+	rems := make([]*big.Int, len(weights))
+	left := new(big.Int).Set(n)
+	for i, w := range weights {
+		parts[i], rems[i] = new(big.Int).QuoRem(new(big.Int).Mul(n, w.big()), total, new(big.Int))
+		left.Sub(left, new(big.Int).Mul(parts[i], big.NewInt(counts[i])))
+	}
+
+	// Fewer units are left over than there are parts with a remainder, so
+	// parts without one never get any. The parts in a run all have the same
+	// remainder, and runs are in order, so the earliest parts of the earliest
+	// runs win ties.
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return rems[order[i]].Cmp(rems[order[j]]) > 0
+	})
+	extra = make([]int64, len(weights))
+	for _, i := range order {
+		if left.Sign() == 0 {
+			break
+		}
+		extra[i] = counts[i]
+		if left.Cmp(big.NewInt(counts[i])) < 0 {
+			extra[i] = left.Int64()
+		}
+		left.Sub(left, big.NewInt(extra[i]))
+	}
+
+	for _, p := range parts {
+		p.Mul(p, unit)
+		if neg {
+			p.Neg(p)
+		}
+	}
+	if neg {
+		unit.Neg(unit)
+	}
+	return parts, extra, unit
+}
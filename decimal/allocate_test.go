@@ -0,0 +1,110 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func decimals(is ...int64) []Decimal {
+	ds := make([]Decimal, len(is))
+	for i, n := range is {
+		ds[i] = FromI64(n)
+	}
+	return ds
+}
+
+var allocateTests = []struct {
+	d       Decimal
+	weights []Decimal
+	exp     int
+	parts   []Decimal
+}{
+	{FromI64(100), decimals(1, 1, 1), 0, decimals(34, 33, 33)},
+	{FromI64(-100), decimals(1, 1, 1), 0, decimals(-34, -33, -33)},
+	{FromI64(100), decimals(1, 2, 3), 0, decimals(17, 33, 50)},
+	{FromI64(5), decimals(0, 1, 0, 1), 0, decimals(0, 3, 0, 2)},
+	{FromI64(1000), decimals(1, 1, 1), 2, decimals(400, 300, 300)},
+	{FromI64(700), decimals(30, 70), 2, decimals(200, 500)},
+	{FromI64(0), decimals(1, 2), 0, decimals(0, 0)},
+	{FromI64(10), decimals(1, 0, 0), 0, decimals(10, 0, 0)},
+	{Decimal{1 << 62, 0}, decimals(1, 1), 0, []Decimal{{1 << 61, 0}, {1 << 61, 0}}},
+}
+
+func TestAllocate(t *testing.T) {
+	for i, test := range allocateTests {
+		parts := test.d.Allocate(test.weights, test.exp)
+		if len(parts) != len(test.parts) {
+			t.Errorf("[%d] expected %d parts got %d", i, len(test.parts), len(parts))
+			continue
+		}
+		var sum Decimal
+		for j, p := range parts {
+			if p != test.parts[j] {
+				t.Errorf("[%d] expected part %d %v got %v", i, j, test.parts[j], p)
+			}
+			sum = sum.Add(p)
+		}
+		if sum != test.d {
+			t.Errorf("[%d] parts sum to %v, not %v", i, sum, test.d)
+		}
+	}
+}
+
+func TestAllocateRuns(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		d := FromI64(10 * (r.Int63n(2000) - 1000))
+		var weights, expanded []Decimal
+		var counts []int64
+		for j := r.Intn(4); j >= 0; j-- {
+			w, n := FromI64(r.Int63n(4)), r.Int63n(5)
+			weights, counts = append(weights, w), append(counts, n)
+			for k := int64(0); k < n; k++ {
+				expanded = append(expanded, w)
+			}
+		}
+		var total Decimal
+		for _, w := range expanded {
+			total = total.Add(w)
+		}
+		if total.Sign() == 0 {
+			continue
+		}
+
+		want := d.Allocate(expanded, 1)
+		parts, extra := d.AllocateRuns(weights, counts, 1)
+		unit := FromI64(10 * int64(d.Sign()))
+		k := 0
+		for j, p := range parts {
+			for n := int64(0); n < counts[j]; n, k = n+1, k+1 {
+				got := p
+				if n < extra[j] {
+					got = got.Add(unit)
+				}
+				if got != want[k] {
+					t.Errorf("[%d] %v over %v %v: expected part %d %v got %v", i, d, weights, counts, k, want[k], got)
+				}
+			}
+		}
+	}
+}
+
+func TestAllocatePanics(t *testing.T) {
+	for i, f := range []func(){
+		func() { FromI64(150).Allocate(decimals(1, 1), 2) },
+		func() { FromI64(100).Allocate(decimals(1, -1), 0) },
+		func() { FromI64(100).Allocate(decimals(0, 0), 0) },
+		func() { FromI64(100).Allocate(nil, 0) },
+		func() { FromI64(100).AllocateRuns(decimals(1, 1), []int64{1}, 0) },
+		func() { FromI64(100).AllocateRuns(decimals(1, 1), []int64{1, -1}, 0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("[%d] expected panic", i)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,137 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// MaxPrecision is the largest precision, in decimal digits, of the decimal
+// columns of Apache Arrow and Parquet that store 16-byte values, which are
+// declared as decimal(precision, scale). Every Decimal with a Precision of at
+// most MaxPrecision fits in such a column.
+const MaxPrecision = 38
+
+// ByteOrder is the byte order of the 16-byte two's-complement encoding of a
+// Decimal.
+type ByteOrder int
+
+const (
+	// LittleEndian is the byte order of Apache Arrow's decimal128 columns,
+	// and the one used by Write and ReadDecimal.
+	LittleEndian ByteOrder = iota
+	// BigEndian is the byte order of Parquet's decimal columns stored as
+	// FIXED_LEN_BYTE_ARRAY.
+	BigEndian
+)
+
+// WriteLittleEndian writes the Decimal as a 128-bit little-endian
+// two's-complement integer to the first 16 bytes of the given byte slice. It
+// is equivalent to Write.
+func (d Decimal) WriteLittleEndian(buf []byte) {
+	d.Write(buf)
+}
+
+// ReadLittleEndian reads a Decimal, encoded as a 128-bit little-endian
+// two's-complement integer, from the given byte slice. It is equivalent to
+// ReadDecimal.
+func ReadLittleEndian(buf []byte) Decimal {
+	return ReadDecimal(buf)
+}
+
+// WriteBigEndian writes the Decimal as a 128-bit big-endian two's-complement
+// integer to the first 16 bytes of the given byte slice.
+func (d Decimal) WriteBigEndian(buf []byte) {
+	binary.BigEndian.PutUint64(buf[0:8], d.hi)
+	binary.BigEndian.PutUint64(buf[8:16], d.lo)
+}
+
+// ReadBigEndian reads a Decimal, encoded as a 128-bit big-endian two's-complement
+// integer, from the given byte slice.
+func ReadBigEndian(buf []byte) Decimal {
+	hi := binary.BigEndian.Uint64(buf[0:8])
+	lo := binary.BigEndian.Uint64(buf[8:16])
+	return Decimal{hi, lo}
+}
+
+// EncodeColumn appends the Decimals to dst as 16-byte two's-complement integers
+// in the given byte order, which is the layout of the values of Arrow and
+// Parquet decimal(38, s) columns, and returns the extended buffer. It doesn't
+// check the Decimals' precision: use Precision to check that they fit in the
+// column.
+func EncodeColumn(dst []byte, ds []Decimal, order ByteOrder) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, 16*len(ds))...)
+	for _, d := range ds {
+		if order == BigEndian {
+			d.WriteBigEndian(dst[n:])
+		} else {
+			d.Write(dst[n:])
+		}
+		n += 16
+	}
+	return dst
+}
+
+// DecodeColumn appends the Decimals encoded in src by EncodeColumn, in the
+// given byte order, to dst and returns the extended slice. It returns an error
+// if the length of src isn't a multiple of 16.
+func DecodeColumn(dst []Decimal, src []byte, order ByteOrder) ([]Decimal, error) {
+	if len(src)%16 != 0 {
+		return dst, fmt.Errorf("decimal: column of %d bytes isn't made of 16-byte values", len(src))
+	}
+	for ; len(src) > 0; src = src[16:] {
+		if order == BigEndian {
+			dst = append(dst, ReadBigEndian(src))
+		} else {
+			dst = append(dst, ReadDecimal(src))
+		}
+	}
+	return dst, nil
+}
+
+// Precision returns the number of decimal digits in the Decimal, ignoring its
+// sign. Zero has a precision of 1.
+func (d Decimal) Precision() int {
+	var buf [40]byte
+	s := d.AppendString(buf[:0])
+	if s[0] == '-' {
+		return len(s) - 1
+	}
+	return len(s)
+}
+
+// Rescale converts a Decimal with an implicit scaling factor of 10**from to one
+// with a scaling factor of 10**to, for instance to convert a number of
+// millionths to a number of hundredths. It returns false if the result can't be
+// represented exactly, either because the Decimal has non-zero digits that
+// would be dropped, or because the result is too large.
+func (d Decimal) Rescale(from, to int) (Decimal, bool) {
+	c, neg := d.signAbs()
+	if from == to || c == (Decimal{}) {
+		return d, true
+	} else if c.hi>>63 == 1 {
+		// The smallest Decimal has no trailing zeros, and can't grow
+		return Decimal{}, false
+	}
+
+	var buf [4]uint64
+	if from > to {
+		for _, p := range pow10(buf[:0], from-to) {
+			var r uint64
+			if c, r = c.divmod(p); r != 0 {
+				return Decimal{}, false
+			}
+		}
+	} else {
+		for _, p := range pow10(buf[:0], to-from) {
+			var ok bool
+			if c, ok = c.mulU64(p); !ok {
+				return Decimal{}, false
+			}
+		}
+	}
+	if neg {
+		c = c.Neg()
+	}
+	return c, true
+}
@@ -0,0 +1,115 @@
+package decimal
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+var columnTests = []struct {
+	d      Decimal
+	le, be string
+}{
+	{FromI64(0), "00000000000000000000000000000000", "00000000000000000000000000000000"},
+	{FromI64(1), "01000000000000000000000000000000", "00000000000000000000000000000001"},
+	{FromI64(-1), "ffffffffffffffffffffffffffffffff", "ffffffffffffffffffffffffffffffff"},
+	{FromI64(-1234), "2efbffffffffffffffffffffffffffff", "fffffffffffffffffffffffffffffb2e"},
+	{Decimal{0x0102030405060708, 0x090a0b0c0d0e0f10}, "100f0e0d0c0b0a090807060504030201", "0102030405060708090a0b0c0d0e0f10"},
+}
+
+func TestEncodeColumn(t *testing.T) {
+	var ds []Decimal
+	var le, be string
+	for i, test := range columnTests {
+		buf := make([]byte, 16)
+		test.d.WriteLittleEndian(buf)
+		if s := hex.EncodeToString(buf); s != test.le {
+			t.Errorf("[%d] expected %q got %q", i, test.le, s)
+		}
+		if d := ReadLittleEndian(buf); d != test.d {
+			t.Errorf("[%d] expected %v got %v", i, test.d, d)
+		}
+		test.d.WriteBigEndian(buf)
+		if s := hex.EncodeToString(buf); s != test.be {
+			t.Errorf("[%d] expected %q got %q", i, test.be, s)
+		}
+		if d := ReadBigEndian(buf); d != test.d {
+			t.Errorf("[%d] expected %v got %v", i, test.d, d)
+		}
+		ds, le, be = append(ds, test.d), le+test.le, be+test.be
+	}
+
+	for _, order := range []ByteOrder{LittleEndian, BigEndian} {
+		want := le
+		if order == BigEndian {
+			want = be
+		}
+		buf := EncodeColumn([]byte("x"), ds, order)
+		if s := hex.EncodeToString(buf); s != "78"+want {
+			t.Errorf("%d: expected %q got %q", order, "78"+want, s)
+		}
+		out, err := DecodeColumn([]Decimal{FromI64(7)}, buf[1:], order)
+		if err != nil {
+			t.Errorf("%d: unexpected error %v", order, err)
+		} else if len(out) != len(ds)+1 || out[0] != FromI64(7) {
+			t.Errorf("%d: expected %v got %v", order, ds, out)
+		} else {
+			for i := range ds {
+				if out[i+1] != ds[i] {
+					t.Errorf("%d: [%d] expected %v got %v", order, i, ds[i], out[i+1])
+				}
+			}
+		}
+	}
+
+	if _, err := DecodeColumn(nil, make([]byte, 17), BigEndian); err == nil {
+		t.Error("expected error decoding 17 bytes")
+	}
+}
+
+var precisionTests = []struct {
+	d Decimal
+	p int
+}{
+	{FromI64(0), 1},
+	{FromI64(9), 1},
+	{FromI64(-10), 2},
+	{FromI64(123456), 6},
+	{Decimal{0x4b3b4ca85a86c47a, 0x098a223fffffffff}, 38},
+	{Decimal{0x4b3b4ca85a86c47a, 0x098a224000000000}, 39},
+	{Decimal{0x8000000000000000, 0}, 39},
+}
+
+func TestPrecision(t *testing.T) {
+	for i, test := range precisionTests {
+		if p := test.d.Precision(); p != test.p {
+			t.Errorf("[%d] expected %d got %d", i, test.p, p)
+		}
+	}
+}
+
+var rescaleTests = []struct {
+	d        Decimal
+	from, to int
+	out      Decimal
+	ok       bool
+}{
+	{FromI64(12340000), 6, 2, FromI64(1234), true},
+	{FromI64(-12340000), 6, 2, FromI64(-1234), true},
+	{FromI64(12345000), 6, 2, Decimal{}, false},
+	{FromI64(-1234), 2, 6, FromI64(-12340000), true},
+	{FromI64(5), 0, 30, Decimal{0x3f1bdf1011, 0x6048a59340000000}, true},
+	{FromI64(1), 0, 38, Decimal{0x4b3b4ca85a86c47a, 0x098a224000000000}, true},
+	{FromI64(2), 0, 38, Decimal{}, false},
+	{FromI64(0), 0, 1000, FromI64(0), true},
+	{Decimal{0x8000000000000000, 0}, 0, 0, Decimal{0x8000000000000000, 0}, true},
+	{Decimal{0x8000000000000000, 0}, 1, 0, Decimal{}, false},
+}
+
+func TestRescale(t *testing.T) {
+	for i, test := range rescaleTests {
+		d, ok := test.d.Rescale(test.from, test.to)
+		if ok != test.ok || (ok && d != test.out) {
+			t.Errorf("[%d] expected %v %v got %v %v", i, test.out, test.ok, d, ok)
+		}
+	}
+}
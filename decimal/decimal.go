@@ -21,6 +21,12 @@ func FromI64(i int64) Decimal {
 	return Decimal{0, uint64(i)}
 }
 
+// Int64 returns the Decimal as an int64, and whether it fits in one.
+func (d Decimal) Int64() (int64, bool) {
+	i := int64(d.lo)
+	return i, (d.hi == 0 && i >= 0) || (d.hi == ^uint64(0) && i < 0)
+}
+
 // Add returns a Decimal that is the sum of its two operands.
 func (d Decimal) Add(o Decimal) Decimal {
 	lo, carry := bits.Add64(d.lo, o.lo, 0)
@@ -28,6 +34,15 @@ func (d Decimal) Add(o Decimal) Decimal {
 	return Decimal{hi, lo}
 }
 
+// AddOverflow returns the sum of its two operands, and whether the sum
+// overflowed. If it overflowed, the returned sum has wrapped around.
+func (d Decimal) AddOverflow(o Decimal) (Decimal, bool) {
+	s := d.Add(o)
+	// Overflow occurs when both operands have the same sign, and the sum
+	// has a different one
+	return s, ((d.hi^s.hi)&(o.hi^s.hi))>>63 == 1
+}
+
 // Sub returns a Decimal that is the result of subtracting its second operand
 // from its first operand.
 func (d Decimal) Sub(o Decimal) Decimal {
@@ -162,7 +177,7 @@ func (d Decimal) divmod(n uint64) (Decimal, uint64) {
 	return Decimal{q2, q1}, r1
 }
 
-// Lt returns true if the Decimal argument is less than the given Decimal.
+// Lt returns true if the receiver is less than the argument.
 func (d Decimal) Lt(o Decimal) bool {
 	da, dn := d.signAbs()
 	oa, on := o.signAbs()
@@ -187,7 +202,7 @@ func (d Decimal) Lt(o Decimal) bool {
 	}
 }
 
-// Lte returns true if the Decimal argument is less than or equal to the given Decimal.
+// Lte returns true if the receiver is less than or equal to the argument.
 func (d Decimal) Lte(o Decimal) bool {
 	if d == o {
 		return true
@@ -200,49 +215,105 @@ func (d Decimal) Eq(o Decimal) bool {
 	return d == o
 }
 
-// Gt returns true if the Decimal argument is greater than the given Decimal.
+// Gt returns true if the receiver is greater than the argument.
 func (d Decimal) Gt(o Decimal) bool {
 	return !d.Lte(o)
 }
 
-// Gte returns true if the Decimal argument is greater than or equal to the
-// given Decimal.
+// Gte returns true if the receiver is greater than or equal to the argument.
 func (d Decimal) Gte(o Decimal) bool {
 	return !d.Lt(o)
 }
 
+// Cmp returns -1 if the receiver is less than the argument, 0 if they are
+// equal, and 1 if the receiver is greater than the argument.
+func (d Decimal) Cmp(o Decimal) int {
+	if d == o {
+		return 0
+	} else if d.Lt(o) {
+		return -1
+	}
+	return 1
+}
+
+// Sign returns -1 if the Decimal is negative, 0 if it is zero, and 1 if it is
+// positive.
+func (d Decimal) Sign() int {
+	if d.hi>>63 == 1 {
+		return -1
+	} else if d.hi == 0 && d.lo == 0 {
+		return 0
+	}
+	return 1
+}
+
 // String returns a decimal string representing the given value.
 func (d Decimal) String() string {
 	var buf [40]byte
-	k := len(buf) - 1
-	d, s := d.signAbs()
-	var rem uint64
-
-	// I'm sure we can do better than this with a little bit of effort, but
-	// this runs in a couple hundred nanoseconds on my computer, which is
-	// fast enough for me for now.
-	for d.hi != 0 || d.lo != 0 {
-		d, rem = d.divmod(10000000000)
-		for i := 0; i < 10; i++ {
-			buf[k] = '0' + byte(rem%10)
-			k = k - 1
-			rem = rem / 10
-		}
-	}
+	return string(d.AppendString(buf[:0]))
+}
 
-	for i := 0; i < len(buf); i++ {
-		if buf[i] == 0 || buf[i] == '0' {
-			continue
-		}
+// pow19 is the largest power of ten that fits in a uint64.
+const pow19 = 10000000000000000000
+
+// digitPairs contains the two-digit decimal representations of 0 to 99.
+const digitPairs = "00010203040506070809" +
+	"10111213141516171819" +
+	"20212223242526272829" +
+	"30313233343536373839" +
+	"40414243444546474849" +
+	"50515253545556575859" +
+	"60616263646566676869" +
+	"70717273747576777879" +
+	"80818283848586878889" +
+	"90919293949596979899"
+
+// putDigits writes the decimal digits of n to the end of buf, two at a time, and
+// returns the index of the first digit.
+func putDigits(buf []byte, n uint64) int {
+	i := len(buf)
+	for n >= 100 {
+		q := n / 100
+		r := (n - q*100) * 2
+		i -= 2
+		buf[i], buf[i+1] = digitPairs[r], digitPairs[r+1]
+		n = q
+	}
+	if n >= 10 {
+		i -= 2
+		buf[i], buf[i+1] = digitPairs[n*2], digitPairs[n*2+1]
+	} else {
+		i--
+		buf[i] = byte('0' + n)
+	}
+	return i
+}
 
-		if s {
-			i = i - 1
-			buf[i] = '-'
+// AppendString appends the decimal string representing the given value, as
+// returned by String, to dst and returns the extended buffer. It doesn't
+// allocate if dst has enough capacity.
+func (d Decimal) AppendString(dst []byte) []byte {
+	var buf [40]byte
+	abs, neg := d.signAbs()
+	// The absolute value of a Decimal is less than 2**127, so dividing it
+	// by 10**19 leaves a quotient and remainder that fit in a uint64.
+	i := len(buf)
+	if abs.hi == 0 {
+		i = putDigits(buf[:], abs.lo)
+	} else {
+		q, r := bits.Div64(abs.hi, abs.lo, pow19)
+		i = putDigits(buf[:], r)
+		for i > len(buf)-19 {
+			i--
+			buf[i] = '0'
 		}
-		return string(buf[i:])
+		i = putDigits(buf[:i], q)
 	}
-
-	return "0"
+	if neg {
+		i--
+		buf[i] = '-'
+	}
+	return append(dst, buf[i:]...)
 }
 
 // ReadDecimal reads a Decimal, encoded as a 128-bit little-endian value, from
@@ -259,3 +330,46 @@ func (d Decimal) Write(buf []byte) {
 	binary.LittleEndian.PutUint64(buf[0:8], d.lo)
 	binary.LittleEndian.PutUint64(buf[8:16], d.hi)
 }
+
+// AppendVarint appends the Decimal to b as a zig-zag encoded varint and returns
+// the extended buffer. The encoding is that of encoding/binary's PutVarint,
+// extended to 128 bits, so values of small magnitude take few bytes: for
+// instance, those between -64 and 63 take just one. At most 19 bytes are
+// appended.
+func (d Decimal) AppendVarint(b []byte) []byte {
+	// Zig-zag encode the value, so that the sign is its lowest bit
+	s := uint64(int64(d.hi) >> 63)
+	hi, lo := (d.hi<<1|d.lo>>63)^s, (d.lo<<1)^s
+	for hi != 0 || lo >= 0x80 {
+		b = append(b, byte(lo)|0x80)
+		lo = lo>>7 | hi<<57
+		hi >>= 7
+	}
+	return append(b, byte(lo))
+}
+
+// ReadVarint reads a Decimal encoded by AppendVarint from the start of buf, and
+// returns it along with the number of bytes read. Like encoding/binary's
+// Varint, the number of bytes is 0 if buf is too small, and negative (the
+// negation of the number of bytes read) if the value overflows a Decimal.
+func ReadVarint(buf []byte) (Decimal, int) {
+	var hi, lo uint64
+	for i, c := range buf {
+		if i == 18 && c > 3 {
+			// The last byte only holds the top two bits
+			return Decimal{}, -(i + 1)
+		}
+		v, shift := uint64(c&0x7f), uint(7*i)
+		if shift < 64 {
+			lo |= v << shift
+			hi |= v >> (64 - shift)
+		} else {
+			hi |= v << (shift - 64)
+		}
+		if c < 0x80 {
+			s := -(lo & 1)
+			return Decimal{(hi >> 1) ^ s, (lo>>1 | hi<<63) ^ s}, i + 1
+		}
+	}
+	return Decimal{}, 0
+}
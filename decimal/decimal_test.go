@@ -1,6 +1,12 @@
 package decimal
 
-import "testing"
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+)
 
 var addTests = []struct {
 	a, b, c Decimal
@@ -134,6 +140,33 @@ func TestComparisons(t *testing.T) {
 		if r := test.a.Gt(test.b); r != test.gt {
 			t.Errorf("[%d] gt expected %v got %v", i, test.gt, r)
 		}
+
+		cmp := 0
+		if test.lt {
+			cmp = -1
+		} else if test.gt {
+			cmp = 1
+		}
+		if r := test.a.Cmp(test.b); r != cmp {
+			t.Errorf("[%d] cmp expected %d got %d", i, cmp, r)
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	for i, test := range []struct {
+		d    Decimal
+		sign int
+	}{
+		{FromI64(-10), -1},
+		{FromI64(0), 0},
+		{FromI64(10), 1},
+		{Decimal{1, 0}, 1},
+		{Decimal{0xf000000000000000, 0}, -1},
+	} {
+		if r := test.d.Sign(); r != test.sign {
+			t.Errorf("[%d] expected %d got %d", i, test.sign, r)
+		}
 	}
 }
 
@@ -160,12 +193,101 @@ func TestString(t *testing.T) {
 	}
 }
 
+// divmodString is the original implementation of String, which divides by 1e10
+// and generates one digit at a time. It is kept to check and benchmark String
+// against.
+func divmodString(d Decimal) string {
+	var buf [40]byte
+	k := len(buf) - 1
+	d, s := d.signAbs()
+	var rem uint64
+
+	for d.hi != 0 || d.lo != 0 {
+		d, rem = d.divmod(10000000000)
+		for i := 0; i < 10; i++ {
+			buf[k] = '0' + byte(rem%10)
+			k = k - 1
+			rem = rem / 10
+		}
+	}
+
+	for i := 0; i < len(buf); i++ {
+		if buf[i] == 0 || buf[i] == '0' {
+			continue
+		}
+
+		if s {
+			i = i - 1
+			buf[i] = '-'
+		}
+		return string(buf[i:])
+	}
+
+	return "0"
+}
+
+func TestStringMatchesDivmod(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		// Vary the magnitude, so every number of digits is covered
+		d := Decimal{r.Uint64(), r.Uint64()}
+		shift := uint(r.Intn(128))
+		if shift >= 64 {
+			d = Decimal{0, d.lo >> (shift - 64)}
+		} else {
+			d = Decimal{d.hi >> shift, d.lo}
+		}
+		if r.Intn(2) == 0 {
+			d = d.Neg()
+		}
+		if s, e := d.String(), divmodString(d); s != e {
+			t.Fatalf("%#v: expected %q got %q", d, e, s)
+		}
+	}
+	if s := (Decimal{1 << 63, 0}).String(); s != "-170141183460469231731687303715884105728" {
+		t.Errorf("expected the smallest Decimal got %q", s)
+	}
+}
+
+func TestAppendString(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "x="...)
+	d := Decimal{0x5897e7bd6715a370, 0x17c4aea0fd62d52b}
+	if s := string(d.AppendString(buf)); s != "x="+d.String() {
+		t.Errorf("expected %q got %q", "x="+d.String(), s)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		d.AppendString(buf[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("AppendString allocated %v times", allocs)
+	}
+}
+
+func BenchmarkStringDivmod(b *testing.B) {
+	d := Decimal{0x5897e7bd6715a370, 0x17c4aea0fd62d52b}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = divmodString(d)
+	}
+}
+
+func BenchmarkStringDivmodSmall(b *testing.B) {
+	d := FromI64(302187286)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = divmodString(d)
+	}
+}
+
 func BenchmarkString(b *testing.B) {
 	d := Decimal{0x5897e7bd6715a370, 0x17c4aea0fd62d52b}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		d.String()
+		_ = d.String()
 	}
 }
 
@@ -174,7 +296,7 @@ func BenchmarkStringSmall(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		d.String()
+		_ = d.String()
 	}
 }
 
@@ -188,3 +310,78 @@ func TestRoundTrip(t *testing.T) {
 		t.Errorf("%#v != %#v", d, d2)
 	}
 }
+
+var varintTests = []struct {
+	d   Decimal
+	enc string
+}{
+	{FromI64(0), "00"},
+	{FromI64(-1), "01"},
+	{FromI64(63), "7e"},
+	{FromI64(-64), "7f"},
+	{FromI64(64), "8001"},
+	{FromI64(12340000), "c0ace20b"},
+	{Decimal{0, 1 << 63}, "80808080808080808002"},
+	{Decimal{0x7fffffffffffffff, 0xffffffffffffffff}, "feffffffffffffffffffffffffffffffffff03"},
+	{Decimal{0x8000000000000000, 0}, "ffffffffffffffffffffffffffffffffffff03"},
+}
+
+func TestVarint(t *testing.T) {
+	for i, test := range varintTests {
+		enc := hex.EncodeToString(test.d.AppendVarint(nil))
+		if enc != test.enc {
+			t.Errorf("[%d] expected %q got %q", i, test.enc, enc)
+		}
+		buf, _ := hex.DecodeString(test.enc)
+		if d, n := ReadVarint(append(buf, 0xff)); d != test.d || n != len(buf) {
+			t.Errorf("[%d] expected %v (%d bytes) got %v (%d bytes)", i, test.d, len(buf), d, n)
+		}
+		if _, n := ReadVarint(buf[:len(buf)-1]); n != 0 {
+			t.Errorf("[%d] expected truncated varint got %d bytes", i, n)
+		}
+	}
+
+	overflow := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04}
+	if _, n := ReadVarint(overflow); n != -19 {
+		t.Errorf("expected overflow got %d bytes", n)
+	}
+}
+
+func TestVarintMatchesBinary(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		v := r.Int63() >> uint(r.Intn(63))
+		if r.Intn(2) == 0 {
+			v = -v
+		}
+		want := make([]byte, binary.MaxVarintLen64)
+		want = want[:binary.PutVarint(want, v)]
+		if got := FromI64(v).AppendVarint(nil); !bytes.Equal(got, want) {
+			t.Fatalf("%d: expected %x got %x", v, want, got)
+		}
+	}
+}
+
+func TestVarintRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		d := Decimal{r.Uint64() >> uint(r.Intn(64)), r.Uint64()}
+		if r.Intn(2) == 0 {
+			d = d.Neg()
+		}
+		buf := d.AppendVarint(nil)
+		if d2, n := ReadVarint(buf); d2 != d || n != len(buf) {
+			t.Fatalf("%v: decoded %x as %v (%d bytes)", d, buf, d2, n)
+		}
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	d := Decimal{0x5897e7bd6715a370, 0x17c4aea0fd62d52b}
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendString(buf[:0])
+	}
+}
@@ -0,0 +1,60 @@
+package decimal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zenazn/money/internal/format"
+)
+
+// Format implements fmt.Formatter. The verbs %v, %s and %d print the Decimal as
+// an integer, %f and %F print it with the given precision (which defaults to
+// zero), and %#v prints it as Go syntax. The flags '+', ' ', '-' and '0' and
+// widths behave as they do for integers, and the '#' flag groups digits by
+// thousands, like "1,234,567"; Go's fmt package doesn't accept the "'" flag
+// that C uses for grouping.
+func (d Decimal) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'd', 'f', 'F':
+	default:
+		format.BadVerb(f, verb, "decimal.Decimal", d.String())
+		return
+	}
+	if verb == 'v' && f.Flag('#') {
+		f.Write([]byte(d.GoString()))
+		return
+	}
+
+	s := d.String()
+	neg := s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	var frac string
+	if verb == 'f' || verb == 'F' {
+		if p, ok := f.Precision(); ok {
+			frac = strings.Repeat("0", p)
+		}
+	}
+	format.Number(f, "", neg, s, frac, true)
+}
+
+// GoString returns Go syntax that evaluates to the Decimal, like
+// "decimal.FromI64(-5)".
+func (d Decimal) GoString() string {
+	if i, ok := d.Int64(); ok {
+		return fmt.Sprintf("decimal.FromI64(%d)", i)
+	}
+	var buf [16]byte
+	d.Write(buf[:])
+	var b strings.Builder
+	b.WriteString("decimal.ReadDecimal([]byte{")
+	for i, c := range buf {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%#02x", c)
+	}
+	b.WriteString("})")
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package decimal
+
+import (
+	"fmt"
+	"testing"
+)
+
+var formatTests = []struct {
+	format string
+	d      Decimal
+	s      string
+}{
+	{"%v", FromI64(-1234567), "-1234567"},
+	{"%s", FromI64(1234567), "1234567"},
+	{"%d", FromI64(1234567), "1234567"},
+	{"%+d", FromI64(1234567), "+1234567"},
+	{"%+d", FromI64(-1234567), "-1234567"},
+	{"% d", FromI64(12), " 12"},
+	{"%#d", FromI64(-1234567), "-1,234,567"},
+	{"%#d", FromI64(123), "123"},
+	{"%#d", FromI64(123456), "123,456"},
+	{"%6d", FromI64(-12), "   -12"},
+	{"%-6d|", FromI64(-12), "-12   |"},
+	{"%06d", FromI64(-12), "-00012"},
+	{"%f", FromI64(12), "12"},
+	{"%.2f", FromI64(-12), "-12.00"},
+	{"%8.2f", FromI64(12), "   12.00"},
+	{"%#v", FromI64(-5), "decimal.FromI64(-5)"},
+	{"%#v", Decimal{0x1234, 0x5}, "decimal.ReadDecimal([]byte{0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})"},
+	{"%x", FromI64(12), "%!x(decimal.Decimal=12)"},
+	{"%d", Decimal{0x4b3b4ca85a86c47a, 0x098a223fffffffff}, "99999999999999999999999999999999999999"},
+}
+
+func TestFormat(t *testing.T) {
+	for i, test := range formatTests {
+		if s := fmt.Sprintf(test.format, test.d); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+	if d := ReadDecimal([]byte{0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}); d != (Decimal{0x1234, 0x5}) {
+		t.Errorf("GoString doesn't round trip: %v", d)
+	}
+}
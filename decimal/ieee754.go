@@ -0,0 +1,124 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IEEE 754-2008 decimal128 values, in the binary integer decimal (BID)
+// encoding, consist of a sign bit, a 14-bit biased exponent and a 113-bit
+// coefficient, of which only values up to 10**34-1 are canonical.
+const (
+	bidBias   = 6176
+	bidMaxExp = 6111
+	bidMinExp = -bidBias
+)
+
+// bidMaxCoefficient is 10**34-1, the largest canonical coefficient.
+var bidMaxCoefficient = Decimal{0x1ed09bead87c0, 0x378d8e63ffffffff}
+
+// bidCoefficientFits returns whether a non-negative Decimal, which may have
+// wrapped around to appear negative, is a canonical coefficient.
+func bidCoefficientFits(c Decimal) bool {
+	m := bidMaxCoefficient
+	return c.hi < m.hi || (c.hi == m.hi && c.lo <= m.lo)
+}
+
+// ToIEEE754Decimal128 returns the Decimal, interpreted as a fixed-point number
+// scaled by 10**scale, as an IEEE 754-2008 decimal128 in the binary integer
+// decimal (BID) encoding used by, among others, MongoDB's Decimal128. hi and lo
+// are the high and low 64 bits of the encoding. For instance, the Decimal 1234
+// with a scale of 6 is encoded as 0.001234.
+//
+// The exponent of the result is -scale where possible, so values keep their
+// scale across a round trip. ToIEEE754Decimal128 returns an error if the value
+// can't be represented exactly, which can only happen if it has more than 34
+// significant digits or if scale is extreme.
+func (d Decimal) ToIEEE754Decimal128(scale int) (hi, lo uint64, err error) {
+	c, neg := d.signAbs()
+	exp := -scale
+	if c == (Decimal{}) {
+		// Every exponent represents zero, so clamp it into range
+		if exp > bidMaxExp {
+			exp = bidMaxExp
+		} else if exp < bidMinExp {
+			exp = bidMinExp
+		}
+	}
+
+	// Drop trailing zeros from coefficients that don't fit, or whose
+	// exponents are too small
+	for !bidCoefficientFits(c) || exp < bidMinExp {
+		var r uint64
+		if c.hi>>63 == 0 {
+			c, r = c.divmod(10)
+		}
+		if c.hi>>63 == 1 || r != 0 {
+			return 0, 0, fmt.Errorf("decimal: can't represent %v with scale %d as a decimal128", d, scale)
+		}
+		exp++
+	}
+	// Add trailing zeros to coefficients whose exponents are too large
+	for exp > bidMaxExp {
+		var ok bool
+		if c, ok = c.mulU64(10); !ok || !bidCoefficientFits(c) {
+			return 0, 0, fmt.Errorf("decimal: can't represent %v with scale %d as a decimal128", d, scale)
+		}
+		exp--
+	}
+
+	hi = uint64(exp+bidBias)<<49 | c.hi
+	if neg {
+		hi |= 1 << 63
+	}
+	return hi, c.lo, nil
+}
+
+// FromIEEE754Decimal128 returns the IEEE 754-2008 decimal128, in the binary
+// integer decimal (BID) encoding with high and low 64 bits hi and lo, as a
+// Decimal scaled by 10**scale; it is the inverse of ToIEEE754Decimal128.
+//
+// FromIEEE754Decimal128 returns an error if the value is a NaN or an infinity,
+// if its coefficient is non-canonical (greater than 10**34-1), if it is more
+// precise than scale allows, or if it is too large for a Decimal. Negative zero
+// is returned as zero.
+func FromIEEE754Decimal128(hi, lo uint64, scale int) (Decimal, error) {
+	switch {
+	case hi>>58&0x1f == 0x1f:
+		return Decimal{}, errors.New("decimal: decimal128 is NaN")
+	case hi>>58&0x1f == 0x1e:
+		return Decimal{}, errors.New("decimal: decimal128 is infinite")
+	case hi>>61&0x3 == 0x3:
+		// The implicit high bits of this form make for coefficients of
+		// at least 2**113, which are never canonical
+		return Decimal{}, errors.New("decimal: decimal128 coefficient out of range")
+	}
+	neg := hi>>63 == 1
+	exp := int(hi>>49&0x3fff) - bidBias
+	c := Decimal{hi & (1<<49 - 1), lo}
+	if !bidCoefficientFits(c) {
+		return Decimal{}, errors.New("decimal: decimal128 coefficient out of range")
+	} else if c == (Decimal{}) {
+		return c, nil
+	}
+
+	for shift := exp + scale; shift != 0; {
+		if shift > 0 {
+			var ok bool
+			if c, ok = c.mulU64(10); !ok {
+				return Decimal{}, errors.New("decimal: decimal128 out of range")
+			}
+			shift--
+		} else {
+			var r uint64
+			if c, r = c.divmod(10); r != 0 {
+				return Decimal{}, fmt.Errorf("decimal: decimal128 is more precise than scale %d", scale)
+			}
+			shift++
+		}
+	}
+	if neg {
+		c = c.Neg()
+	}
+	return c, nil
+}
@@ -0,0 +1,116 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var decimal128Tests = []struct {
+	d      Decimal
+	scale  int
+	hi, lo uint64
+}{
+	// Vectors from the BSON corpus's decimal128 tests
+	{FromI64(0), 0, 0x3040000000000000, 0},
+	{FromI64(1), 0, 0x3040000000000000, 1},
+	{FromI64(-1), 0, 0xb040000000000000, 1},
+	{FromI64(1), 1, 0x303e000000000000, 1},
+	{FromI64(1234), 6, 0x3034000000000000, 0x4d2},
+	{FromI64(123456789012), 0, 0x3040000000000000, 0x1cbe991a14},
+	{FromI64(123400000), 11, 0x302a000000000000, 0x75aef40},
+	{Decimal{0x3cde6fff9732, 0xde825cd07e96aff2}, 34, 0x2ffc3cde6fff9732, 0xde825cd07e96aff2},
+
+	{FromI64(-12340000), 6, 0xb034000000000000, 0xbc4b20},
+	{bidMaxCoefficient, 0, 0x3041ed09bead87c0, 0x378d8e63ffffffff},
+	// 10**37 has too many digits, so its trailing zeros move into the
+	// exponent
+	{Decimal{0x785ee10d5da46d9, 0xf436a000000000}, 0, 0x3048314dc6448d93, 0x38c15b0a00000000},
+	{FromI64(0), -7000, 0x5ffe000000000000, 0},
+	{FromI64(0), 7000, 0, 0},
+}
+
+func TestToIEEE754Decimal128(t *testing.T) {
+	for i, test := range decimal128Tests {
+		hi, lo, err := test.d.ToIEEE754Decimal128(test.scale)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if hi != test.hi || lo != test.lo {
+			t.Errorf("[%d] expected %#016x %#016x got %#016x %#016x", i, test.hi, test.lo, hi, lo)
+		}
+	}
+
+	for i, d := range []Decimal{
+		{0x7fffffffffffffff, 0xffffffffffffffff},
+		{0x8000000000000000, 0},
+		{0x785ee10d5da46d9, 0xf436a000000001},
+	} {
+		if hi, lo, err := d.ToIEEE754Decimal128(0); err == nil {
+			t.Errorf("[%d] expected error got %#016x %#016x", i, hi, lo)
+		}
+	}
+	if hi, lo, err := FromI64(1).ToIEEE754Decimal128(-6200); err == nil {
+		t.Errorf("expected error got %#016x %#016x", hi, lo)
+	}
+}
+
+func TestFromIEEE754Decimal128(t *testing.T) {
+	for i, test := range decimal128Tests {
+		d, err := FromIEEE754Decimal128(test.hi, test.lo, test.scale)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if d != test.d {
+			t.Errorf("[%d] expected %v got %v", i, test.d, d)
+		}
+	}
+
+	// -0 and 1.000000 (with a scale of 2)
+	if d, err := FromIEEE754Decimal128(0xb040000000000000, 0, 6); err != nil || d != FromI64(0) {
+		t.Errorf("expected 0 got %v %v", d, err)
+	}
+	if d, err := FromIEEE754Decimal128(0x3034000000000000, 1000000, 2); err != nil || d != FromI64(100) {
+		t.Errorf("expected 100 got %v %v", d, err)
+	}
+}
+
+var decimal128FailureTests = []struct {
+	hi, lo uint64
+	scale  int
+}{
+	{0x7c00000000000000, 0, 6},                  // NaN
+	{0xfc00000000000000, 0, 6},                  // -NaN
+	{0x7e00000000000000, 0, 6},                  // sNaN
+	{0x7800000000000000, 0, 6},                  // Infinity
+	{0xf800000000000000, 0, 6},                  // -Infinity
+	{0x6c10000000000000, 0, 6},                  // Coefficient with implicit high bits
+	{0x3041ed09bead87c0, 0x378d8e6400000000, 0}, // 10**34
+	{0x303e000000000000, 1, 0},                  // 0.1
+	{0x3034000000000000, 1234567, 5},            // 1.234567
+	{0x3040000000000000 + 39<<49, 2, 0},         // 2E+39
+}
+
+func TestFromIEEE754Decimal128Failures(t *testing.T) {
+	for i, test := range decimal128FailureTests {
+		if d, err := FromIEEE754Decimal128(test.hi, test.lo, test.scale); err == nil {
+			t.Errorf("[%d] unexpectedly passed: %v", i, d)
+		}
+	}
+}
+
+func TestIEEE754Decimal128RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		// 112 bits always fit in a canonical coefficient
+		d := Decimal{r.Uint64() >> uint(16+r.Intn(48)), r.Uint64()}
+		if r.Intn(2) == 0 {
+			d = d.Neg()
+		}
+		scale := r.Intn(20)
+		hi, lo, err := d.ToIEEE754Decimal128(scale)
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", d, err)
+		}
+		if d2, err := FromIEEE754Decimal128(hi, lo, scale); err != nil || d2 != d {
+			t.Fatalf("%v: round tripped to %v %v", d, d2, err)
+		}
+	}
+}
@@ -13,6 +13,11 @@ func NewRate(ppm int64) Rate {
 	return Rate{ppm}
 }
 
+// PPM returns the Rate as a number of parts-per-million.
+func (r Rate) PPM() int64 {
+	return r.r
+}
+
 // Add returns a new Rate that is the sum of the two arguments.
 func (r Rate) Add(o Rate) Rate {
 	return Rate{r.r + o.r}
@@ -0,0 +1,299 @@
+package decimal
+
+import "math/big"
+
+// The functions in this file compute with fixed-point big.Ints, scaled by
+// fixScale, which has far more precision than a Rate. Each function rounds its
+// result to a Rate only once, so the results are correctly rounded (to the
+// nearest part per million, and ties to even) except when the exact result is
+// within about 1e-30 of halfway between two Rates. In particular, results are
+// always within one part per million of the exact value.
+
+const fixDigits = 40
+
+var (
+	fixScale = pow10Big(fixDigits)
+	// fixPerPPM is the fixed-point value of one part per million.
+	fixPerPPM = pow10Big(fixDigits - 6)
+	fixE      = fixExpFrac(new(big.Int).Set(fixScale))
+	fixLn2    = fixLnReduced(new(big.Int).Lsh(fixScale, 1))
+	// fixMaxRate is the largest fixed-point value that rounds to a Rate,
+	// and fixMaxFactor is larger than any factor a non-zero Decimal can be
+	// multiplied by without overflowing.
+	fixMaxRate   = new(big.Int).Mul(big.NewInt(1<<63-1), fixPerPPM)
+	fixMaxFactor = new(big.Int).Lsh(fixScale, 128)
+)
+
+func pow10Big(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (r Rate) fixed() *big.Int {
+	return new(big.Int).Mul(big.NewInt(r.r), fixPerPPM)
+}
+
+// quoRound returns a/b rounded half-even.
+func quoRound(a, b *big.Int) *big.Int {
+	q, m := new(big.Int).QuoRem(a, b, new(big.Int))
+	half := m.Abs(m).Lsh(m, 1).CmpAbs(b)
+	if half > 0 || (half == 0 && q.Bit(0) == 1) {
+		if (a.Sign() < 0) != (b.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func fixMul(a, b *big.Int) *big.Int {
+	return quoRound(new(big.Int).Mul(a, b), fixScale)
+}
+
+func fixDiv(a, b *big.Int) *big.Int {
+	return quoRound(new(big.Int).Mul(a, fixScale), b)
+}
+
+// fixRate rounds a fixed-point value to a Rate, or panics with the given
+// message if it is out of range.
+func fixRate(f *big.Int, op string) Rate {
+	q := quoRound(f, fixPerPPM)
+	if !q.IsInt64() {
+		panic("decimal: " + op + ": overflow")
+	}
+	return Rate{q.Int64()}
+}
+
+// fixPowInt raises a fixed-point value to a non-negative power by squaring. It
+// returns nil if the magnitude of the result exceeds limit, unless limit is
+// nil.
+func fixPowInt(x *big.Int, n uint64, limit *big.Int) *big.Int {
+	out := new(big.Int).Set(fixScale)
+	// Powers of numbers no larger than one can't overflow, and they
+	// converge to zero, so there's no need to keep computing them
+	grows := limit != nil && x.CmpAbs(fixScale) > 0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			out = fixMul(out, x)
+			if grows && out.CmpAbs(limit) > 0 {
+				return nil
+			}
+		}
+		if n > 1 {
+			x = fixMul(x, x)
+			if grows && x.CmpAbs(limit) > 0 {
+				return nil
+			}
+			if x.Sign() == 0 {
+				return x
+			}
+		}
+	}
+	return out
+}
+
+// PowInt returns the Rate raised to the given integer power, which may be
+// negative. PowInt panics if the Rate is zero and the power is negative, or if
+// the result can't be represented by a Rate.
+func (r Rate) PowInt(n int) Rate {
+	x := r.fixed()
+	if n < 0 {
+		if r.r == 0 {
+			panic("decimal: pow: divide by zero")
+		}
+		x = fixDiv(fixScale, x)
+	}
+	un := uint64(n)
+	if n < 0 {
+		un = -un
+	}
+	out := fixPowInt(x, un, fixMaxRate)
+	if out == nil {
+		panic("decimal: pow: overflow")
+	}
+	return fixRate(out, "pow")
+}
+
+// fixExpFrac returns e**x, for 0 <= x < 1, using its Taylor series.
+func fixExpFrac(x *big.Int) *big.Int {
+	sum := new(big.Int).Set(fixScale)
+	term := new(big.Int).Set(fixScale)
+	for i := int64(1); term.Sign() != 0; i++ {
+		term = quoRound(fixMul(term, x), big.NewInt(i))
+		sum.Add(sum, term)
+	}
+	return sum
+}
+
+// fixExp returns e**x, or nil if it exceeds the given limit.
+func fixExp(x, limit *big.Int) *big.Int {
+	// Split x into integer and fractional parts, so x = n + f, where n is
+	// an integer and 0 <= f < 1, and e**x = e**n * e**f.
+	n, f := new(big.Int).DivMod(x, fixScale, new(big.Int))
+	if !n.IsInt64() || n.Int64() > 100 {
+		// e**100 is larger than any limit
+		return nil
+	} else if n.Int64() < -100 {
+		// e**-100 is less than 1e-43, which rounds to zero
+		return new(big.Int)
+	}
+
+	var en *big.Int
+	if n.Sign() < 0 {
+		en = fixDiv(fixScale, fixPowInt(fixE, uint64(-n.Int64()), nil))
+	} else if en = fixPowInt(fixE, uint64(n.Int64()), limit); en == nil {
+		return nil
+	}
+	out := fixMul(en, fixExpFrac(f))
+	if out.CmpAbs(limit) > 0 {
+		return nil
+	}
+	return out
+}
+
+// Exp returns e raised to the power of the Rate. Exp panics if the result can't
+// be represented by a Rate, which is the case for Rates larger than about 29.8.
+func (r Rate) Exp() Rate {
+	out := fixExp(r.fixed(), fixMaxRate)
+	if out == nil {
+		panic("decimal: exp: overflow")
+	}
+	return fixRate(out, "exp")
+}
+
+// fixLnReduced returns ln(x) for 1 <= x <= 2, using the series
+// ln(x) = 2*atanh(z), where z = (x-1)/(x+1) is at most 1/3.
+func fixLnReduced(x *big.Int) *big.Int {
+	z := fixDiv(new(big.Int).Sub(x, fixScale), new(big.Int).Add(x, fixScale))
+	z2 := fixMul(z, z)
+	sum := new(big.Int)
+	for i := int64(1); z.Sign() != 0; i += 2 {
+		sum.Add(sum, quoRound(z, big.NewInt(i)))
+		z = fixMul(z, z2)
+	}
+	return sum.Lsh(sum, 1)
+}
+
+// fixLn returns ln(x) for positive x.
+func fixLn(x *big.Int) *big.Int {
+	// Write x = m * 2**k, where 1 <= m < 2, so ln(x) = ln(m) + k*ln(2).
+	// x has at most 64 bits more than fixScale and is at least 1 ppm, so
+	// shifting loses no significant precision.
+	var k int64
+	m := new(big.Int).Set(x)
+	for m.Cmp(fixScale) < 0 {
+		m.Lsh(m, 1)
+		k--
+	}
+	twice := new(big.Int).Lsh(fixScale, 1)
+	for m.Cmp(twice) >= 0 {
+		m = quoRound(m, big.NewInt(2))
+		k++
+	}
+	out := fixLnReduced(m)
+	return out.Add(out, new(big.Int).Mul(big.NewInt(k), fixLn2))
+}
+
+// Ln returns the natural logarithm of the Rate. Ln panics if the Rate is not
+// positive.
+func (r Rate) Ln() Rate {
+	if r.r <= 0 {
+		panic("decimal: ln: rate is not positive")
+	}
+	return fixRate(fixLn(r.fixed()), "ln")
+}
+
+// Pow returns the Rate raised to the power of the given Rate. Negative Rates
+// may only be raised to integer powers. Pow panics if the Rate is negative and
+// the power is not an integer, if the Rate is zero and the power is negative,
+// or if the result can't be represented by a Rate.
+func (r Rate) Pow(o Rate) Rate {
+	if o.r%rateBase == 0 {
+		return r.PowInt(int(o.r / rateBase))
+	}
+	if r.r < 0 {
+		panic("decimal: pow: negative rate raised to a fractional power")
+	} else if r.r == 0 {
+		if o.r < 0 {
+			panic("decimal: pow: divide by zero")
+		}
+		return Rate{0}
+	}
+	out := fixExp(fixMul(o.fixed(), fixLn(r.fixed())), fixMaxRate)
+	if out == nil {
+		panic("decimal: pow: overflow")
+	}
+	return fixRate(out, "pow")
+}
+
+// Root returns the nth root of the Rate, which may be negative if n is odd. Root
+// panics if n is zero, if the Rate is negative and n is even, if the Rate is
+// zero and n is negative, or if the result can't be represented by a Rate.
+func (r Rate) Root(n int) Rate {
+	if n == 0 {
+		panic("decimal: root: zeroth root")
+	}
+	if r.r == 0 {
+		if n < 0 {
+			panic("decimal: root: divide by zero")
+		}
+		return Rate{0}
+	}
+	abs, neg := r.signAbs()
+	if neg && n%2 == 0 {
+		panic("decimal: root: even root of a negative rate")
+	}
+	out := fixExp(quoRound(fixLn(abs.fixed()), big.NewInt(int64(n))), fixMaxRate)
+	if out == nil {
+		panic("decimal: root: overflow")
+	}
+	if neg {
+		out.Neg(out)
+	}
+	return fixRate(out, "root")
+}
+
+// MulPow returns the Decimal multiplied by the Rate raised to the power num/den,
+// rounded to the nearest integer using Bankers Rounding, and whether the result
+// overflowed. The power is computed with far more precision than a Rate has, so
+// MulPow is suitable for compounding and discounting large values. MulPow
+// panics if den is not positive, if the Rate is negative and the power is not
+// an integer, or if the Rate is zero and the power is negative.
+func (d Decimal) MulPow(r Rate, num, den int64) (Decimal, bool) {
+	if den <= 0 {
+		panic("decimal: mulpow: non-positive denominator")
+	}
+
+	var factor *big.Int
+	if num%den == 0 {
+		n := num / den
+		x := r.fixed()
+		if n < 0 {
+			if r.r == 0 {
+				panic("decimal: mulpow: divide by zero")
+			}
+			x = fixDiv(fixScale, x)
+			n = -n
+		}
+		factor = fixPowInt(x, uint64(n), fixMaxFactor)
+	} else {
+		if r.r < 0 {
+			panic("decimal: mulpow: negative rate raised to a fractional power")
+		} else if r.r == 0 {
+			if num < 0 {
+				panic("decimal: mulpow: divide by zero")
+			}
+			return Decimal{}, false
+		}
+		x := new(big.Int).Mul(fixLn(r.fixed()), big.NewInt(num))
+		factor = fixExp(quoRound(x, big.NewInt(den)), fixMaxFactor)
+	}
+
+	if d == (Decimal{}) {
+		return d, false
+	} else if factor == nil {
+		return Decimal{}, true
+	}
+	out, ok := fromBig(quoRound(new(big.Int).Mul(d.big(), factor), fixScale))
+	return out, !ok
+}
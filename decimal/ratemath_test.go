@@ -0,0 +1,184 @@
+package decimal
+
+import "testing"
+
+var powIntTests = []struct {
+	r   int64
+	n   int
+	out int64
+}{
+	{1050000, 10, 1628895},
+	{1050000, 0, 1000000},
+	{1050000, 1, 1050000},
+	{1050000, -1, 952381},
+	{2000000, -1, 500000},
+	{-2000000, 3, -8000000},
+	{-2000000, -3, -125000},
+	{500000, 30, 0},
+	{1004167, 360, 4468278},
+	{0, 5, 0},
+	{0, 0, 1000000},
+	{1000000, 1 << 40, 1000000},
+}
+
+func TestRatePowInt(t *testing.T) {
+	for i, test := range powIntTests {
+		if r := NewRate(test.r).PowInt(test.n); r.r != test.out {
+			t.Errorf("[%d] expected %d got %d", i, test.out, r.r)
+		}
+	}
+}
+
+var expTests = []struct {
+	r, exp int64
+}{
+	{0, 1000000},
+	{1000000, 2718282},
+	{-1000000, 367879},
+	{693147, 2000000},
+	{29000000, 3931334297144042074},
+	{-40000000, 0},
+}
+
+func TestRateExp(t *testing.T) {
+	for i, test := range expTests {
+		if r := NewRate(test.r).Exp(); r.r != test.exp {
+			t.Errorf("[%d] expected %d got %d", i, test.exp, r.r)
+		}
+	}
+}
+
+var lnTests = []struct {
+	r, ln int64
+}{
+	{1000000, 0},
+	{2000000, 693147},
+	{2718282, 1000000},
+	{500000, -693147},
+	{1, -13815511},
+	{1 << 62, 29159615},
+	{1050000, 48790},
+}
+
+func TestRateLn(t *testing.T) {
+	for i, test := range lnTests {
+		if r := NewRate(test.r).Ln(); r.r != test.ln {
+			t.Errorf("[%d] expected %d got %d", i, test.ln, r.r)
+		}
+	}
+}
+
+var powTests = []struct {
+	r, o, out int64
+}{
+	{2000000, 500000, 1414214},
+	{1050000, 83333, 1004074},
+	{4000000, -500000, 500000},
+	{-2000000, 2000000, 4000000},
+	{0, 500000, 0},
+	{1062500, 1000000, 1062500},
+}
+
+func TestRatePow(t *testing.T) {
+	for i, test := range powTests {
+		if r := NewRate(test.r).Pow(NewRate(test.o)); r.r != test.out {
+			t.Errorf("[%d] expected %d got %d", i, test.out, r.r)
+		}
+	}
+}
+
+var rootTests = []struct {
+	r   int64
+	n   int
+	out int64
+}{
+	{8000000, 3, 2000000},
+	{-8000000, 3, -2000000},
+	{2000000, 2, 1414214},
+	{1050000, 12, 1004074},
+	{4000000, -2, 500000},
+	{0, 3, 0},
+}
+
+func TestRateRoot(t *testing.T) {
+	for i, test := range rootTests {
+		if r := NewRate(test.r).Root(test.n); r.r != test.out {
+			t.Errorf("[%d] expected %d got %d", i, test.out, r.r)
+		}
+	}
+}
+
+func TestRateMathPanics(t *testing.T) {
+	for i, f := range []func(){
+		func() { NewRate(0).PowInt(-1) },
+		func() { NewRate(10000000).PowInt(20) },
+		func() { NewRate(31000000).Exp() },
+		func() { NewRate(0).Ln() },
+		func() { NewRate(-1).Ln() },
+		func() { NewRate(-2000000).Pow(NewRate(500000)) },
+		func() { NewRate(0).Pow(NewRate(-500000)) },
+		func() { NewRate(-4000000).Root(2) },
+		func() { NewRate(4000000).Root(0) },
+		func() { NewRate(0).Root(-2) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("[%d] expected panic", i)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+var mulPowTests = []struct {
+	d, r     int64
+	num, den int64
+	out      int64
+	overflow bool
+}{
+	{1000000000, 1100000, -2, 1, 826446281, false},
+	{1000000000, 1100000, 2, 1, 1210000000, false},
+	{1000000000, 1100000, -1, 2, 953462589, false},
+	{1000000000, 1100000, 183, 365, 1048945792, false},
+	{-1000000000, 2000000, 1, 2, -1414213562, false},
+	{1000000000, -2000000, 3, 1, -8000000000, false},
+	{1000000000, 0, 3, 1, 0, false},
+	{1000000000, 0, 1, 2, 0, false},
+	{0, 1000, -1000, 1, 0, false},
+	{1000000000, 1000, -1000, 1, 0, true},
+	{1000000000, 1000000000, 10, 1, 0, true},
+	{1000000000, 999999, 1000000000, 1, 0, false},
+	{1000000000, 999999, 1000, 1, 999000499, false},
+}
+
+func TestMulPow(t *testing.T) {
+	for i, test := range mulPowTests {
+		out, overflow := FromI64(test.d).MulPow(NewRate(test.r), test.num, test.den)
+		if overflow != test.overflow {
+			t.Errorf("[%d] expected overflow=%v", i, test.overflow)
+		} else if !overflow && out != FromI64(test.out) {
+			t.Errorf("[%d] expected %d got %s", i, test.out, out)
+		}
+	}
+}
+
+func TestInt64(t *testing.T) {
+	for i, test := range []struct {
+		d  Decimal
+		i  int64
+		ok bool
+	}{
+		{FromI64(17), 17, true},
+		{FromI64(-17), -17, true},
+		{FromI64(1<<63 - 1), 1<<63 - 1, true},
+		{FromI64(-1 << 63), -1 << 63, true},
+		{FromI64(1<<63 - 1).Add(FromI64(1)), 0, false},
+		{FromI64(-1 << 63).Sub(FromI64(1)), 0, false},
+	} {
+		if r, ok := test.d.Int64(); ok != test.ok || (ok && r != test.i) {
+			t.Errorf("[%d] expected %d, %v got %d, %v", i, test.i, test.ok, r, ok)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package decimal
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// RoundingMode determines how a value that can't be represented exactly is
+// rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and rounds ties to the
+	// nearest even value. It is also known as Bankers Rounding, and is used
+	// by Mul and Div.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and rounds ties away from
+	// zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value, and rounds ties towards
+	// zero.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero, truncating the value.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+// maxPow10 is the largest power of ten that can be doubled without overflowing
+// a uint64.
+const maxPow10 = 1000000000000000000
+
+// pow10 splits 10**exp into factors no larger than maxPow10, appending them to
+// out.
+func pow10(out []uint64, exp int) []uint64 {
+	for ; exp > 18; exp -= 18 {
+		out = append(out, maxPow10)
+	}
+	p := uint64(1)
+	for ; exp > 0; exp-- {
+		p *= 10
+	}
+	return append(out, p)
+}
+
+// Round returns the multiple of 10**exp nearest to the given Decimal, using the
+// given rounding mode to break ties. If exp is not positive, Round returns the
+// Decimal unchanged. Round panics if the result can't be represented by a
+// Decimal.
+func (d Decimal) Round(exp int, mode RoundingMode) Decimal {
+	if exp <= 0 {
+		return d
+	}
+	return d.DivInt(1, exp, mode)
+}
+
+// DivInt returns the given Decimal divided by n, rounded to a multiple of
+// 10**exp using the given rounding mode. Unlike dividing and then rounding, it
+// only rounds once, so it never suffers from double rounding. DivInt panics if
+// n is zero, or if the result can't be represented by a Decimal.
+func (d Decimal) DivInt(n int64, exp int, mode RoundingMode) Decimal {
+	if n == 0 {
+		panic("decimal: div: divide by zero")
+	}
+	if exp < 0 {
+		exp = 0
+	}
+	un, nSign := uint64(n), n < 0
+	if nSign {
+		un = -un
+	}
+	// buf has room for the factors of exponents up to 54 and for un, which
+	// covers every exponent that matters for a Decimal
+	var buf [4]uint64
+	scale := pow10(buf[:0], exp)
+	q, neg := d.signAbs()
+	neg = neg != nSign
+
+	// half tracks how the remainder so far compares to half of the
+	// divisors so far (-1, 0 or 1), and inexact whether it is non-zero.
+	half, inexact := -1, false
+	for _, p := range append(scale, un) {
+		var r uint64
+		q, r = q.divmod(p)
+		switch {
+		case r > p/2:
+			half = 1
+		case r == p/2 && p%2 == 0:
+			// The remainder is exactly half, unless the lower digits
+			// were non-zero
+			if inexact {
+				half = 1
+			} else {
+				half = 0
+			}
+		case r == p/2:
+			// 2r == p-1, so the comparison is decided by the lower
+			// digits, and half is unchanged
+		default:
+			half = -1
+		}
+		inexact = inexact || r != 0
+	}
+
+	up := mode.roundUp(half, inexact, neg, q.lo&1 == 1)
+	if up {
+		q = q.Add(Decimal{0, 1})
+	}
+
+	for _, p := range scale {
+		var ok bool
+		if q, ok = q.mulU64(p); !ok {
+			panic("decimal: round: overflow")
+		}
+	}
+	if neg {
+		q = q.Neg()
+	}
+	return q
+}
+
+// roundUp returns whether a truncated quotient should be rounded away from
+// zero. half is -1, 0 or 1 depending on whether the remainder is less than,
+// equal to or greater than half of the divisor, and inexact is whether the
+// remainder is non-zero.
+func (mode RoundingMode) roundUp(half int, inexact, neg, odd bool) bool {
+	switch mode {
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundUp:
+		return inexact
+	case RoundDown:
+		return false
+	case RoundCeiling:
+		return inexact && !neg
+	case RoundFloor:
+		return inexact && neg
+	}
+	panic("decimal: unknown rounding mode")
+}
+
+// MulDiv returns the given Decimal multiplied by num and divided by den, rounded
+// to a multiple of 10**exp using the given rounding mode. The intermediate
+// product is computed exactly, so MulDiv only rounds once. MulDiv panics if den
+// is zero, or if the result can't be represented by a Decimal.
+func (d Decimal) MulDiv(num, den Decimal, exp int, mode RoundingMode) Decimal {
+	if den == (Decimal{}) {
+		panic("decimal: div: divide by zero")
+	}
+	if exp < 0 {
+		exp = 0
+	}
+	n := new(big.Int).Mul(d.big(), num.big())
+	m := den.big()
+	m.Mul(m, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	neg := n.Sign()*m.Sign() < 0
+	n.Abs(n)
+	m.Abs(m)
+
+	q, r := new(big.Int).QuoRem(n, m, new(big.Int))
+	half := r.Lsh(r, 1).Cmp(m)
+	if mode.roundUp(half, r.Sign() != 0, neg, q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(1))
+	}
+	q.Mul(q, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	if neg {
+		q.Neg(q)
+	}
+	out, ok := fromBig(q)
+	if !ok {
+		panic("decimal: muldiv: overflow")
+	}
+	return out
+}
+
+var (
+	bigMax = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	bigMin = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	bigU64 = new(big.Int).SetUint64(^uint64(0))
+)
+
+// big returns the Decimal as a big.Int.
+func (d Decimal) big() *big.Int {
+	b := new(big.Int).SetInt64(int64(d.hi))
+	b.Lsh(b, 64)
+	return b.Or(b, new(big.Int).SetUint64(d.lo))
+}
+
+// fromBig returns the big.Int as a Decimal, or false if it is out of range.
+func fromBig(b *big.Int) (Decimal, bool) {
+	if b.Cmp(bigMax) > 0 || b.Cmp(bigMin) < 0 {
+		return Decimal{}, false
+	}
+	// Two's complement of negative numbers
+	t := new(big.Int).Set(b)
+	if t.Sign() < 0 {
+		t.Add(t, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	lo := new(big.Int).And(t, bigU64).Uint64()
+	hi := t.Rsh(t, 64).Uint64()
+	return Decimal{hi, lo}, true
+}
+
+// mulU64 multiplies a non-negative Decimal by n. It returns false if the result
+// overflows.
+func (d Decimal) mulU64(n uint64) (Decimal, bool) {
+	h1, lo := bits.Mul64(d.lo, n)
+	h2, l2 := bits.Mul64(d.hi, n)
+	hi, c := bits.Add64(h1, l2, 0)
+	return Decimal{hi, lo}, h2 == 0 && c == 0 && hi>>63 == 0
+}
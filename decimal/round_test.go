@@ -0,0 +1,133 @@
+package decimal
+
+import "testing"
+
+var roundTests = []struct {
+	d    int64
+	exp  int
+	mode RoundingMode
+	out  int64
+}{
+	{1250, 2, RoundHalfEven, 1200},
+	{1350, 2, RoundHalfEven, 1400},
+	{1251, 2, RoundHalfEven, 1300},
+	{-1250, 2, RoundHalfEven, -1200},
+	{1250, 2, RoundHalfUp, 1300},
+	{-1250, 2, RoundHalfUp, -1300},
+	{1249, 2, RoundHalfUp, 1200},
+	{1250, 2, RoundHalfDown, 1200},
+	{1251, 2, RoundHalfDown, 1300},
+	{1201, 2, RoundUp, 1300},
+	{-1201, 2, RoundUp, -1300},
+	{1200, 2, RoundUp, 1200},
+	{1299, 2, RoundDown, 1200},
+	{-1299, 2, RoundDown, -1200},
+	{1201, 2, RoundCeiling, 1300},
+	{-1299, 2, RoundCeiling, -1200},
+	{1299, 2, RoundFloor, 1200},
+	{-1201, 2, RoundFloor, -1300},
+	{1234, 0, RoundUp, 1234},
+	{1234, -3, RoundUp, 1234},
+	{4, 1, RoundHalfEven, 0},
+	{5, 1, RoundHalfUp, 10},
+}
+
+func TestRound(t *testing.T) {
+	for i, test := range roundTests {
+		if o := FromI64(test.d).Round(test.exp, test.mode); o != FromI64(test.out) {
+			t.Errorf("[%d] expected %d got %s", i, test.out, o)
+		}
+	}
+}
+
+var divIntTests = []struct {
+	d, n int64
+	exp  int
+	mode RoundingMode
+	out  int64
+}{
+	{100, 3, 0, RoundHalfEven, 33},
+	{200, 3, 0, RoundHalfEven, 67},
+	{-200, 3, 0, RoundHalfEven, -67},
+	{200, -3, 0, RoundHalfEven, -67},
+	{100, 3, 1, RoundHalfEven, 30},
+	{100, 3, 1, RoundUp, 40},
+	{150, 3, 2, RoundHalfEven, 0},
+	{150, 3, 2, RoundHalfUp, 100},
+	// 1049/7 is 149.857..., which rounds to 100. Rounding to units first
+	// would produce 150, which then rounds to 200.
+	{1049, 7, 2, RoundHalfUp, 100},
+	// 7/2 is 3.5, which is a tie only in the last divisor
+	{7, 2, 0, RoundHalfEven, 4},
+	{5, 2, 0, RoundHalfEven, 2},
+	// Ties in an odd divisor are decided by the lower digits
+	{1499, 3, 3, RoundHalfDown, 0},
+	{1501, 3, 3, RoundHalfDown, 1000},
+	{1500, 1, 3, RoundHalfDown, 1000},
+	{1500, 3, 2, RoundHalfDown, 500},
+	{45, 9, 1, RoundHalfDown, 0},
+	{45, 1, 1, RoundHalfDown, 40},
+	{135, 3, 1, RoundHalfDown, 40},
+	{15, 3, 1, RoundHalfUp, 10},
+}
+
+func TestDivInt(t *testing.T) {
+	for i, test := range divIntTests {
+		if o := FromI64(test.d).DivInt(test.n, test.exp, test.mode); o != FromI64(test.out) {
+			t.Errorf("[%d] expected %d got %s", i, test.out, o)
+		}
+	}
+}
+
+func TestRoundLarge(t *testing.T) {
+	// 10**37 + 5*10**18 - 1, rounded to 10**19
+	d := Decimal{0x785ee10d5da46d9, 0x00f436a000000000}.Add(FromI64(5000000000000000000 - 1))
+	want := Decimal{0x785ee10d5da46d9, 0x00f436a000000000}
+	if o := d.Round(19, RoundHalfUp); o != want {
+		t.Errorf("expected %s got %s", want, o)
+	}
+	if o := d.Add(FromI64(1)).Round(19, RoundHalfDown); o != want {
+		t.Errorf("expected %s got %s", want, o)
+	}
+	if o := d.Add(FromI64(1)).Round(19, RoundHalfUp); o == want {
+		t.Errorf("expected %s to round up", d.Add(FromI64(1)))
+	}
+}
+
+func TestAddOverflow(t *testing.T) {
+	max := Decimal{1<<63 - 1, 1<<64 - 1}
+	if _, overflow := max.AddOverflow(FromI64(1)); !overflow {
+		t.Errorf("expected overflow")
+	}
+	if _, overflow := max.Neg().AddOverflow(FromI64(-2)); !overflow {
+		t.Errorf("expected overflow")
+	}
+	if s, overflow := max.AddOverflow(FromI64(-1)); overflow || s != (Decimal{1<<63 - 1, 1<<64 - 2}) {
+		t.Errorf("expected no overflow, got %s", s)
+	}
+}
+
+var mulDivTests = []struct {
+	d, num, den Decimal
+	exp         int
+	mode        RoundingMode
+	out         Decimal
+}{
+	{FromI64(100), FromI64(2), FromI64(3), 0, RoundHalfEven, FromI64(67)},
+	{FromI64(-100), FromI64(2), FromI64(3), 0, RoundHalfEven, FromI64(-67)},
+	{FromI64(100), FromI64(-2), FromI64(3), 0, RoundDown, FromI64(-66)},
+	{FromI64(100), FromI64(-2), FromI64(-3), 1, RoundUp, FromI64(70)},
+	{FromI64(25), FromI64(1), FromI64(10), 0, RoundHalfEven, FromI64(2)},
+	{FromI64(25), FromI64(1), FromI64(10), 0, RoundHalfUp, FromI64(3)},
+	// The intermediate product doesn't fit in a Decimal
+	{Decimal{1 << 60, 0}, Decimal{1 << 40, 0}, Decimal{1 << 40, 0}, 0, RoundHalfEven, Decimal{1 << 60, 0}},
+	{Decimal{1 << 60, 0}.Neg(), Decimal{1 << 40, 0}, Decimal{1 << 41, 0}, 0, RoundHalfEven, Decimal{1 << 59, 0}.Neg()},
+}
+
+func TestMulDiv(t *testing.T) {
+	for i, test := range mulDivTests {
+		if o := test.d.MulDiv(test.num, test.den, test.exp, test.mode); o != test.out {
+			t.Errorf("[%d] expected %s got %s", i, test.out, o)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+	"github.com/zenazn/money/internal/format"
+)
+
+// Format implements fmt.Formatter. The verbs %v and %s print the value like
+// String, %d prints its amount as an integer number of
+// minimum-representable-units (see Amount), and %f and %F print its amount in
+// major units without a currency code, rounded to the given precision using
+// Bankers Rounding. The precision of %f defaults to the number of digits of the
+// currency's minor units, so for instance "%f" prints USD 1.305 as "1.30", and
+// "%.1f" prints it as "1.3". %#v prints Go syntax that evaluates to the value.
+//
+// The flags '+' and ' ' control the sign, and '#' groups digits by thousands,
+// like "USD 1,234.56"; Go's fmt package doesn't accept the "'" flag that C uses
+// for grouping. The width, if any, pads the value with spaces on the left (or
+// on the right, with the '-' flag), or with zeros after the sign for %d and %f
+// with the '0' flag, so values line up in tabular reports.
+func (m Money) Format(f fmt.State, verb rune) {
+	var buf [64]byte
+	var b []byte
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			f.Write([]byte(m.GoString()))
+			return
+		}
+		b = m.appendText(buf[:0])
+	case 'd':
+		b = m.AppendFormat(buf[:0], 'd', -1)
+	case 'f', 'F':
+		prec, ok := f.Precision()
+		if !ok {
+			prec = -1
+		}
+		b = m.AppendFormat(buf[:0], 'f', prec)
+	default:
+		format.BadVerb(f, verb, "money.Money", m.String())
+		return
+	}
+
+	// Split the currency code, sign, and parts of the amount, so the
+	// flags can be applied to them
+	s := string(b)
+	var prefix string
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		prefix, s = s[:i+1], s[i+1:]
+	}
+	neg := s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	format.Number(f, prefix, neg, whole, frac, verb != 'v' && verb != 's')
+}
+
+// GoString returns Go syntax that evaluates to the value, like
+// "money.FromMinorUnits(130, currency.EUR)".
+func (m Money) GoString() string {
+	if m.ccy == nil {
+		return "money.Money{}"
+	}
+	ccy := fmt.Sprintf("%#v", m.ccy)
+	if c, err := currency.FromISOSymbol(m.ccy.Symbol()); err == nil && c == m.ccy {
+		ccy = "currency." + m.ccy.Symbol()
+	}
+
+	one := decimal.FromI64(1)
+	minor := one
+	for i := minorExp(m.ccy); i > 0; i-- {
+		minor = minor.Mul(ten)
+	}
+	units := m.amt.MulDiv(one, minor, 0, decimal.RoundDown)
+	if n, ok := units.Int64(); ok && units.MulDiv(minor, one, 0, decimal.RoundDown).Eq(m.amt) {
+		return fmt.Sprintf("money.FromMinorUnits(%d, %s)", n, ccy)
+	}
+	return fmt.Sprintf("money.New(%#v, %s)", m.amt, ccy)
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zenazn/money/currency"
+)
+
+var formatTests = []struct {
+	format string
+	m      Money
+	s      string
+}{
+	{"%v", usd(130), "USD 1.30"},
+	{"%s", mustparse("1234567.0187", "USD"), "USD 1234567.0187"},
+	{"%#s", mustparse("1234567.0187", "USD"), "USD 1,234,567.0187"},
+	{"%+s", usd(130), "USD +1.30"},
+	{"%+s", usd(-130), "USD -1.30"},
+	{"%12s|", usd(-130), "   USD -1.30|"},
+	{"%-12s|", usd(-130), "USD -1.30   |"},
+	{"%012s", usd(-130), "   USD -1.30"},
+	{"%s", FromMinorUnits(990, currency.JPY), "JPY 990"},
+	{"%s", Money{}, "0"},
+	{"%d", usd(130), "1300000"},
+	{"%+d", usd(-130), "-1300000"},
+	{"%#d", usd(130), "1,300,000"},
+	{"%f", usd(130), "1.30"},
+	{"%f", mustparse("1.305", "USD"), "1.30"},
+	{"%f", mustparse("1.315", "USD"), "1.32"},
+	{"%.1f", mustparse("1.305", "USD"), "1.3"},
+	{"%.0f", mustparse("2.5", "USD"), "2"},
+	{"%.8f", mustparse("1.305", "USD"), "1.30500000"},
+	{"%.2f", mustparse("0.004", "USD").Neg(), "0.00"},
+	{"%f", FromMinorUnits(-990, currency.JPY), "-990"},
+	{"%f", Money{}, "0"},
+	{"%.2f", Money{}, "0.00"},
+	{"%#10.2f|", mustparse("1234.5", "USD"), "  1,234.50|"},
+	{"%+010.2f", usd(-123450), "-001234.50"},
+	{"% .2f", usd(123450), " 1234.50"},
+	{"%#v", usd(130), "money.FromMinorUnits(130, currency.USD)"},
+	{"%#v", mustparse("1.305", "USD"), "money.New(decimal.FromI64(1305000), currency.USD)"},
+	{"%#v", FromMinorUnits(100, currency.VEF), "money.FromMinorUnits(100, currency.VEF)"},
+	{"%#v", Money{}, "money.Money{}"},
+	{"%x", usd(130), "%!x(money.Money=USD 1.30)"},
+}
+
+func TestFormat(t *testing.T) {
+	for i, test := range formatTests {
+		if s := fmt.Sprintf(test.format, test.m); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+}
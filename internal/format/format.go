@@ -0,0 +1,77 @@
+// Package format implements the fmt.Formatter flags shared by the money and
+// decimal packages.
+package format
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Number writes a number to the given fmt.State, after a prefix such as a
+// currency code. whole and frac are the digits of the whole and fractional parts
+// of the number's absolute value, and frac may be empty.
+//
+// The '+' flag always prints a sign, and the ' ' flag prints a space in place of
+// the sign of positive numbers. The '#' flag groups the digits of the whole part
+// by thousands. If the number is narrower than the width, it is padded with
+// spaces on the left, or on the right with the '-' flag, or with zeros after
+// the sign with the '0' flag if zeros is true.
+func Number(f fmt.State, prefix string, neg bool, whole, frac string, zeros bool) {
+	var sign string
+	if neg {
+		sign = "-"
+	} else if f.Flag('+') {
+		sign = "+"
+	} else if f.Flag(' ') {
+		sign = " "
+	}
+	if f.Flag('#') {
+		whole = group(whole)
+	}
+	if frac != "" {
+		frac = "." + frac
+	}
+
+	pad := 0
+	if w, ok := f.Width(); ok {
+		n := utf8.RuneCountInString(prefix) + len(sign) + len(whole) + len(frac)
+		if n < w {
+			pad = w - n
+		}
+	}
+	switch {
+	case f.Flag('-'):
+		write(f, prefix, sign, whole, frac)
+		write(f, strings.Repeat(" ", pad))
+	case f.Flag('0') && zeros:
+		write(f, prefix, sign, strings.Repeat("0", pad), whole, frac)
+	default:
+		write(f, strings.Repeat(" ", pad), prefix, sign, whole, frac)
+	}
+}
+
+// BadVerb writes fmt's error string for an unsupported verb.
+func BadVerb(f fmt.State, verb rune, typ, value string) {
+	fmt.Fprintf(f, "%%!%c(%s=%s)", verb, typ, value)
+}
+
+func write(f fmt.State, ss ...string) {
+	for _, s := range ss {
+		f.Write([]byte(s))
+	}
+}
+
+func group(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	out := make([]byte, 0, len(digits)+len(digits)/3)
+	for i := 0; i < len(digits); i++ {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, digits[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,286 @@
+// Package ledger implements a double-entry bookkeeping ledger on top of Money.
+//
+// A ledger is made up of accounts, and transactions that move value between
+// them. Each transaction consists of postings, each of which debits (if its
+// amount is positive) or credits (if its amount is negative) a single account.
+// The postings of every transaction must balance: in each currency, the debits
+// must equal the credits. As a result, the balances of all accounts in a ledger
+// always sum to zero in every currency.
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zenazn/money"
+)
+
+// AccountType is the type of an account, which determines whether debits or
+// credits increase its balance.
+type AccountType int
+
+// The types of accounts in the accounting equation.
+const (
+	Asset AccountType = iota
+	Liability
+	Equity
+	Income
+	Expense
+)
+
+var accountTypeNames = [...]string{"asset", "liability", "equity", "income", "expense"}
+
+func (t AccountType) String() string {
+	if t < 0 || int(t) >= len(accountTypeNames) {
+		return fmt.Sprintf("AccountType(%d)", int(t))
+	}
+	return accountTypeNames[t]
+}
+
+// DebitNormal returns true if debits increase the balance of accounts of this
+// type, as they do for assets and expenses.
+func (t AccountType) DebitNormal() bool {
+	return t == Asset || t == Expense
+}
+
+// Account is an account in a ledger.
+type Account struct {
+	// ID uniquely identifies the account within its ledger.
+	ID   string
+	Name string
+	Type AccountType
+}
+
+// Posting is a single debit or credit to an account. Positive amounts are
+// debits, and negative amounts are credits.
+type Posting struct {
+	Account string
+	Amount  money.Money
+}
+
+// Transaction is a set of postings that are applied to a ledger atomically.
+type Transaction struct {
+	// ID uniquely identifies the transaction within its ledger.
+	ID          string
+	Time        time.Time
+	Description string
+	Postings    []Posting
+}
+
+// Errors returned by ledgers and stores.
+var (
+	ErrNotFound = errors.New("ledger: not found")
+	ErrExists   = errors.New("ledger: already exists")
+)
+
+// UnbalancedError is returned for transactions whose postings don't balance.
+type UnbalancedError struct {
+	Transaction string
+	// Imbalance is the sum of the postings in the first currency (by
+	// symbol) that doesn't balance.
+	Imbalance money.Money
+}
+
+func (e *UnbalancedError) Error() string {
+	return fmt.Sprintf("ledger: transaction %s is unbalanced by %s", e.Transaction, e.Imbalance)
+}
+
+// Validate checks that the transaction is well-formed: that it has an ID, that
+// it has at least two postings, that every posting names an account and has a
+// currency, and that its postings balance in every currency. Unbalanced
+// transactions return an *UnbalancedError.
+func (t Transaction) Validate() error {
+	if t.ID == "" {
+		return errors.New("ledger: transaction has no ID")
+	}
+	if len(t.Postings) < 2 {
+		return fmt.Errorf("ledger: transaction %s has fewer than two postings", t.ID)
+	}
+
+	sums := make(map[string]money.Money)
+	for _, p := range t.Postings {
+		if p.Account == "" {
+			return fmt.Errorf("ledger: transaction %s has a posting with no account", t.ID)
+		}
+		if p.Amount.Currency() == nil {
+			return fmt.Errorf("ledger: transaction %s has a posting with no currency", t.ID)
+		}
+		sym := p.Amount.Currency().Symbol()
+		sum, err := sums[sym].AddErr(p.Amount)
+		if err != nil {
+			return err
+		}
+		sums[sym] = sum
+	}
+
+	for _, sym := range sortedKeys(sums) {
+		if !sums[sym].Zero() {
+			return &UnbalancedError{t.ID, sums[sym]}
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]money.Money) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Ledger is a double-entry ledger backed by a Store. It is safe for concurrent
+// use.
+type Ledger struct {
+	store Store
+}
+
+// New returns a Ledger backed by the given store.
+func New(s Store) *Ledger {
+	return &Ledger{s}
+}
+
+// Open adds an account to the ledger. It returns ErrExists if the ledger
+// already has an account with the same ID.
+func (l *Ledger) Open(a Account) error {
+	if a.ID == "" {
+		return errors.New("ledger: account has no ID")
+	}
+	return l.store.CreateAccount(a)
+}
+
+// Account returns the account with the given ID, or ErrNotFound.
+func (l *Ledger) Account(id string) (Account, error) {
+	return l.store.Account(id)
+}
+
+// Post validates the transaction and applies it to the ledger. It returns an
+// error if the transaction is malformed or unbalanced, if it refers to an
+// account that does not exist, or if the ledger already has a transaction with
+// the same ID.
+func (l *Ledger) Post(t Transaction) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	for _, p := range t.Postings {
+		if _, err := l.store.Account(p.Account); err == ErrNotFound {
+			return fmt.Errorf("ledger: transaction %s posts to unknown account %s", t.ID, p.Account)
+		} else if err != nil {
+			return err
+		}
+	}
+	// Don't let the caller modify the postings out from under the store
+	t.Postings = append([]Posting(nil), t.Postings...)
+	return l.store.Append(t)
+}
+
+// Balance returns the balance of the given account: the sum of its debits minus
+// the sum of its credits, in every currency.
+func (l *Ledger) Balance(account string) (money.Bag, error) {
+	if _, err := l.store.Account(account); err != nil {
+		return money.Bag{}, err
+	}
+	txns, err := l.store.Transactions(account)
+	if err != nil {
+		return money.Bag{}, err
+	}
+	var b money.Bag
+	for _, t := range txns {
+		for _, p := range t.Postings {
+			if p.Account == account {
+				b = b.Add(p.Amount)
+			}
+		}
+	}
+	return b, nil
+}
+
+// Entry is a posting to an account, along with the account's balance in the
+// posting's currency after the posting was applied.
+type Entry struct {
+	Transaction string
+	Time        time.Time
+	Amount      money.Money
+	Balance     money.Money
+}
+
+// RunningBalance returns the postings to the given account, in the order they
+// were applied, along with the account's running balance.
+func (l *Ledger) RunningBalance(account string) ([]Entry, error) {
+	if _, err := l.store.Account(account); err != nil {
+		return nil, err
+	}
+	txns, err := l.store.Transactions(account)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	balances := make(map[string]money.Money)
+	for _, t := range txns {
+		for _, p := range t.Postings {
+			if p.Account != account {
+				continue
+			}
+			sym := p.Amount.Currency().Symbol()
+			bal, err := balances[sym].AddErr(p.Amount)
+			if err != nil {
+				return nil, err
+			}
+			balances[sym] = bal
+			entries = append(entries, Entry{t.ID, t.Time, p.Amount, bal})
+		}
+	}
+	return entries, nil
+}
+
+// TrialBalanceLine is the balance of a single account in a single currency.
+// Exactly one of Debit and Credit is non-zero, and both are non-negative.
+type TrialBalanceLine struct {
+	Account Account
+	Debit   money.Money
+	Credit  money.Money
+}
+
+// TrialBalance returns the non-zero balances of every account, ordered by
+// account ID and currency symbol. In each currency, the sum of the debits
+// equals the sum of the credits.
+func (l *Ledger) TrialBalance() ([]TrialBalanceLine, error) {
+	// Only accounts with postings have balances, so the accounts are looked
+	// up from the transactions rather than listed separately, which could
+	// disagree with them if other goroutines are opening accounts and
+	// posting to them
+	txns, err := l.store.Transactions("")
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]money.Bag)
+	for _, t := range txns {
+		for _, p := range t.Postings {
+			balances[p.Account] = balances[p.Account].Add(p.Amount)
+		}
+	}
+
+	ids := make([]string, 0, len(balances))
+	for id := range balances {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var lines []TrialBalanceLine
+	for _, id := range ids {
+		a, err := l.store.Account(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range balances[id].Amounts() {
+			line := TrialBalanceLine{a, m, money.Zero(m.Currency())}
+			if m.Sign() < 0 {
+				line.Debit, line.Credit = line.Credit, m.Neg()
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
@@ -0,0 +1,304 @@
+package ledger
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/zenazn/money"
+	"github.com/zenazn/money/currency"
+)
+
+func usd(cents int64) money.Money {
+	return money.FromMinorUnits(cents, currency.USD)
+}
+
+func eur(cents int64) money.Money {
+	return money.FromMinorUnits(cents, currency.EUR)
+}
+
+var accounts = []Account{
+	{"cash", "Cash", Asset},
+	{"loan", "Bank loan", Liability},
+	{"equity", "Owner's equity", Equity},
+	{"sales", "Sales", Income},
+	{"rent", "Rent", Expense},
+}
+
+func newLedger(t testing.TB) *Ledger {
+	l := New(&MemoryStore{})
+	for _, a := range accounts {
+		if err := l.Open(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return l
+}
+
+var validateTests = []struct {
+	t  Transaction
+	ok bool
+}{
+	{Transaction{ID: "1", Postings: []Posting{{"cash", usd(100)}, {"sales", usd(-100)}}}, true},
+	{Transaction{ID: "2", Postings: []Posting{{"cash", usd(100)}, {"sales", usd(-60)}, {"equity", usd(-40)}}}, true},
+	{Transaction{ID: "3", Postings: []Posting{
+		{"cash", usd(100)}, {"sales", usd(-100)}, {"cash", eur(5)}, {"sales", eur(-5)},
+	}}, true},
+	{Transaction{ID: "4", Postings: []Posting{{"cash", usd(100)}, {"sales", usd(-99)}}}, false},
+	{Transaction{ID: "5", Postings: []Posting{{"cash", usd(100)}, {"sales", eur(-100)}}}, false},
+	{Transaction{ID: "6", Postings: []Posting{{"cash", usd(0)}}}, false},
+	{Transaction{ID: "7", Postings: []Posting{{"cash", usd(1)}, {"", usd(-1)}}}, false},
+	{Transaction{ID: "8", Postings: []Posting{{"cash", money.Money{}}, {"sales", money.Money{}}}}, false},
+	{Transaction{Postings: []Posting{{"cash", usd(100)}, {"sales", usd(-100)}}}, false},
+}
+
+func TestValidate(t *testing.T) {
+	for i, test := range validateTests {
+		if err := test.t.Validate(); (err == nil) != test.ok {
+			t.Errorf("[%d] expected ok=%v got %v", i, test.ok, err)
+		}
+	}
+
+	err := validateTests[4].t.Validate()
+	if ue, ok := err.(*UnbalancedError); !ok {
+		t.Errorf("expected *UnbalancedError got %v", err)
+	} else if ue.Imbalance != eur(-100) {
+		t.Errorf("expected imbalance %s got %s", eur(-100), ue.Imbalance)
+	}
+}
+
+func TestPost(t *testing.T) {
+	l := newLedger(t)
+	txns := []Transaction{
+		{ID: "1", Postings: []Posting{{"cash", usd(10000)}, {"equity", usd(-10000)}}},
+		{ID: "2", Postings: []Posting{{"cash", usd(5000)}, {"loan", usd(-5000)}}},
+		{ID: "3", Postings: []Posting{{"rent", usd(2500)}, {"cash", usd(-2500)}}},
+		{ID: "4", Postings: []Posting{{"cash", eur(700)}, {"sales", eur(-700)}}},
+	}
+	for _, txn := range txns {
+		if err := l.Post(txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := l.Post(txns[0]); err != ErrExists {
+		t.Errorf("expected ErrExists got %v", err)
+	}
+	bad := Transaction{ID: "5", Postings: []Posting{{"cash", usd(1)}, {"nope", usd(-1)}}}
+	if err := l.Post(bad); err == nil {
+		t.Error("expected error posting to unknown account")
+	}
+	if err := l.Open(accounts[0]); err != ErrExists {
+		t.Errorf("expected ErrExists got %v", err)
+	}
+
+	b, err := l.Balance("cash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := b.String(); s != "EUR 7.00, USD 125.00" {
+		t.Errorf("expected %q got %q", "EUR 7.00, USD 125.00", s)
+	}
+	if _, err := l.Balance("nope"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound got %v", err)
+	}
+
+	entries, err := l.RunningBalance("cash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Entry{
+		{"1", txns[0].Time, usd(10000), usd(10000)},
+		{"2", txns[1].Time, usd(5000), usd(15000)},
+		{"3", txns[2].Time, usd(-2500), usd(12500)},
+		{"4", txns[3].Time, eur(700), eur(700)},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries got %d", len(expected), len(entries))
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("[%d] expected %v got %v", i, expected[i], entries[i])
+		}
+	}
+
+	lines, err := l.TrialBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedLines := []struct {
+		account       string
+		debit, credit money.Money
+	}{
+		{"cash", eur(700), eur(0)},
+		{"cash", usd(12500), usd(0)},
+		{"equity", usd(0), usd(10000)},
+		{"loan", usd(0), usd(5000)},
+		{"rent", usd(2500), usd(0)},
+		{"sales", eur(0), eur(700)},
+	}
+	if len(lines) != len(expectedLines) {
+		t.Fatalf("expected %d lines got %d", len(expectedLines), len(lines))
+	}
+	for i, e := range expectedLines {
+		line := lines[i]
+		if line.Account.ID != e.account || line.Debit != e.debit || line.Credit != e.credit {
+			t.Errorf("[%d] expected %v got %v", i, e, line)
+		}
+	}
+}
+
+func TestPostCopiesPostings(t *testing.T) {
+	l := newLedger(t)
+	ps := []Posting{{"cash", usd(100)}, {"sales", usd(-100)}}
+	if err := l.Post(Transaction{ID: "1", Postings: ps}); err != nil {
+		t.Fatal(err)
+	}
+	ps[0].Amount = usd(1000000)
+	if b, _ := l.Balance("cash"); !b.Equal(money.NewBag(usd(100))) {
+		t.Errorf("expected USD 1.00 got %s", b)
+	}
+}
+
+// checkTrialBalance checks that the trial balance balances. Because it is
+// computed from a single snapshot of the ledger's transactions, it balances
+// even while other goroutines are opening accounts and posting.
+func checkTrialBalance(t *testing.T, l *Ledger) {
+	lines, err := l.TrialBalance()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var debits, credits money.Bag
+	for _, line := range lines {
+		if line.Debit.Sign() < 0 || line.Credit.Sign() < 0 {
+			t.Errorf("negative trial balance line %v", line)
+		}
+		debits = debits.Add(line.Debit)
+		credits = credits.Add(line.Credit)
+	}
+	if !debits.Equal(credits) {
+		t.Errorf("expected debits %s to equal credits %s", debits, credits)
+	}
+}
+
+func TestParallelPost(t *testing.T) {
+	l := newLedger(t)
+	const writers = 8
+	const perWriter = 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(int64(w)))
+			for i := 0; i < perWriter; i++ {
+				from := accounts[r.Intn(len(accounts))].ID
+				to := accounts[r.Intn(len(accounts))].ID
+				amt := usd(r.Int63n(100000))
+				if r.Intn(2) == 0 {
+					amt = eur(r.Int63n(100000))
+				}
+				txn := Transaction{
+					ID:       fmt.Sprintf("%d-%d", w, i),
+					Postings: []Posting{{to, amt}, {from, amt.Neg()}},
+				}
+				if err := l.Post(txn); err != nil {
+					t.Error(err)
+					return
+				}
+				// Unbalanced transactions are always rejected
+				txn.ID += "-bad"
+				txn.Postings[0].Amount = amt.Add(money.FromMinorUnits(1, amt.Currency()))
+				if err := l.Post(txn); err == nil {
+					t.Errorf("expected %s to be rejected", txn.ID)
+				}
+			}
+		}(w)
+	}
+
+	// Readers see consistent snapshots while writers are running
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			checkTrialBalance(t, l)
+		}
+	}()
+
+	wg.Wait()
+	<-done
+	checkTrialBalance(t, l)
+
+	var total money.Bag
+	for _, a := range accounts {
+		b, err := l.Balance(a.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total = total.AddBag(b)
+	}
+	if !total.IsZero() {
+		t.Errorf("expected balances to sum to zero, got %s", total)
+	}
+
+	txns, _ := l.store.Transactions("")
+	if len(txns) != writers*perWriter {
+		t.Errorf("expected %d transactions got %d", writers*perWriter, len(txns))
+	}
+}
+
+func TestParallelOpenAndPost(t *testing.T) {
+	l := newLedger(t)
+	const writers = 8
+	const perWriter = 100
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				id := fmt.Sprintf("customer-%d-%d", w, i)
+				if err := l.Open(Account{id, "Customer", Asset}); err != nil {
+					t.Error(err)
+					return
+				}
+				txn := Transaction{
+					ID:       id,
+					Postings: []Posting{{id, usd(int64(i + 1))}, {"sales", usd(-int64(i + 1))}},
+				}
+				if err := l.Post(txn); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+
+	// Accounts opened and posted to while the trial balance is computed
+	// never leave it unbalanced
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		checkTrialBalance(t, l)
+	}
+
+	lines, err := l.TrialBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != writers*perWriter+1 {
+		t.Errorf("expected %d lines got %d", writers*perWriter+1, len(lines))
+	}
+}
@@ -0,0 +1,120 @@
+package ledger
+
+import "sync"
+
+// Store persists the accounts and transactions of a ledger. Ledgers validate
+// transactions before appending them, so stores need not. Implementations must
+// be safe for concurrent use, and must apply each transaction atomically.
+type Store interface {
+	// CreateAccount adds an account, or returns ErrExists if an account
+	// with the same ID already exists.
+	CreateAccount(a Account) error
+	// Account returns the account with the given ID, or ErrNotFound.
+	Account(id string) (Account, error)
+	// Accounts returns every account, in any order.
+	Accounts() ([]Account, error)
+	// Append adds a transaction, or returns ErrExists if a transaction
+	// with the same ID already exists.
+	Append(t Transaction) error
+	// Transactions returns the transactions with postings to the given
+	// account, or every transaction if the account is empty, in the order
+	// they were appended.
+	Transactions(account string) ([]Transaction, error)
+}
+
+// MemoryStore is a Store that keeps everything in memory. The zero value of
+// MemoryStore is an empty store and may be immediately used.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	accounts map[string]Account
+	txnIDs   map[string]bool
+	txns     []Transaction
+	// byAccount contains the indexes into txns of each account's
+	// transactions.
+	byAccount map[string][]int
+}
+
+var _ Store = &MemoryStore{}
+
+// CreateAccount implements Store.
+func (s *MemoryStore) CreateAccount(a Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accounts[a.ID]; ok {
+		return ErrExists
+	}
+	if s.accounts == nil {
+		s.accounts = make(map[string]Account)
+	}
+	s.accounts[a.ID] = a
+	return nil
+}
+
+// Account implements Store.
+func (s *MemoryStore) Account(id string) (Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	a, ok := s.accounts[id]
+	if !ok {
+		return Account{}, ErrNotFound
+	}
+	return a, nil
+}
+
+// Accounts implements Store.
+func (s *MemoryStore) Accounts() ([]Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// Append implements Store.
+func (s *MemoryStore) Append(t Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.txnIDs[t.ID] {
+		return ErrExists
+	}
+	if s.txnIDs == nil {
+		s.txnIDs = make(map[string]bool)
+		s.byAccount = make(map[string][]int)
+	}
+	s.txnIDs[t.ID] = true
+	s.txns = append(s.txns, t)
+
+	idx := len(s.txns) - 1
+	for i, p := range t.Postings {
+		if !postsTo(t.Postings[:i], p.Account) {
+			s.byAccount[p.Account] = append(s.byAccount[p.Account], idx)
+		}
+	}
+	return nil
+}
+
+func postsTo(ps []Posting, account string) bool {
+	for _, p := range ps {
+		if p.Account == account {
+			return true
+		}
+	}
+	return false
+}
+
+// Transactions implements Store.
+func (s *MemoryStore) Transactions(account string) ([]Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if account == "" {
+		return append([]Transaction(nil), s.txns...), nil
+	}
+	idxs := s.byAccount[account]
+	out := make([]Transaction, len(idxs))
+	for i, idx := range idxs {
+		out[i] = s.txns[idx]
+	}
+	return out, nil
+}
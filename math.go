@@ -1,6 +1,9 @@
 package money
 
-import "github.com/zenazn/money/decimal"
+import (
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
 
 // AddErr adds the receiver and argument and returns the result, or an error if
 // the two values were incompatible.
@@ -48,12 +51,54 @@ func (m Money) Div(r decimal.Rate) Money {
 	return Money{m.amt.Div(r), m.ccy}
 }
 
+// MulDivRound multiplies the receiver by num/den, and rounds the result to the
+// minor units of its currency using the given rounding mode. Unlike Mul and
+// Div, it computes the result exactly before rounding it once. MulDivRound
+// panics if den is zero, or if the result can't be represented.
+func (m Money) MulDivRound(num, den decimal.Decimal, mode decimal.RoundingMode) Money {
+	if m.ccy == nil {
+		return m
+	}
+	return Money{m.amt.MulDiv(num, den, minorExp(m.ccy), mode), m.ccy}
+}
+
 // Neg negates the receiver and returns the result.
 func (m Money) Neg() Money {
 	return Money{m.amt.Neg(), m.ccy}
 }
 
-func (m Money) RoundToMinorUnits() Money {
-	// TODO
+// Abs returns the absolute value of the receiver.
+func (m Money) Abs() Money {
+	if m.Sign() < 0 {
+		return m.Neg()
+	}
 	return m
 }
+
+// Sign returns -1 if the receiver is negative, 0 if it is zero, and 1 if it is
+// positive.
+func (m Money) Sign() int {
+	return m.amt.Sign()
+}
+
+// Round rounds the receiver to the minor units of its currency (for instance,
+// to whole cents for US dollars) using the given rounding mode.
+func (m Money) Round(mode decimal.RoundingMode) Money {
+	if m.ccy == nil {
+		return m
+	}
+	return Money{m.amt.Round(minorExp(m.ccy), mode), m.ccy}
+}
+
+// minorExp returns the power of ten, in units of the currency's internal
+// representation, of the currency's minor units.
+func minorExp(ccy currency.Currency) int {
+	u := ccy.Units()
+	return int(u.MajorUnitScalingFactorExponent) - int(u.MinorUnitsInMajorUnitExponent)
+}
+
+// RoundToMinorUnits rounds the receiver to the minor units of its currency
+// using Bankers Rounding (round-half-even).
+func (m Money) RoundToMinorUnits() Money {
+	return m.Round(decimal.RoundHalfEven)
+}
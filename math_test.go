@@ -75,3 +75,73 @@ func TestNeg(t *testing.T) {
 		t.Error("what's going on")
 	}
 }
+
+var absSignTests = []struct {
+	m, abs Money
+	sign   int
+}{
+	{mxn(10), mxn(10), 1},
+	{mxn(-10), mxn(10), -1},
+	{mxn(0), mxn(0), 0},
+	{Money{}, Money{}, 0},
+}
+
+func TestAbsSign(t *testing.T) {
+	for i, test := range absSignTests {
+		if r := test.m.Abs(); !r.Eq(test.abs) {
+			t.Errorf("[%d] abs expected %s got %s", i, test.abs, r)
+		}
+		if r := test.m.Sign(); r != test.sign {
+			t.Errorf("[%d] sign expected %d got %d", i, test.sign, r)
+		}
+	}
+}
+
+var roundTests = []struct {
+	m    Money
+	mode decimal.RoundingMode
+	out  Money
+}{
+	{mustparse("1.005", "USD"), decimal.RoundHalfEven, usd(100)},
+	{mustparse("1.015", "USD"), decimal.RoundHalfEven, usd(102)},
+	{mustparse("1.005", "USD"), decimal.RoundHalfUp, usd(101)},
+	{mustparse("1.001", "USD").Neg(), decimal.RoundFloor, usd(-101)},
+	{mustparse("1.5", "JPY"), decimal.RoundHalfEven, mustparse("2", "JPY")},
+	{usd(123), decimal.RoundUp, usd(123)},
+	{Money{}, decimal.RoundUp, Money{}},
+}
+
+func TestRound(t *testing.T) {
+	for i, test := range roundTests {
+		if r := test.m.Round(test.mode); !r.Eq(test.out) {
+			t.Errorf("[%d] expected %s got %s", i, test.out, r)
+		}
+	}
+	if r := mustparse("1.125", "USD").RoundToMinorUnits(); !r.Eq(usd(112)) {
+		t.Errorf("expected %s got %s", usd(112), r)
+	}
+}
+
+var mulDivRoundTests = []struct {
+	m        Money
+	num, den int64
+	mode     decimal.RoundingMode
+	out      Money
+}{
+	{usd(100), 1, 3, decimal.RoundHalfEven, usd(33)},
+	{usd(100), 2, 3, decimal.RoundHalfEven, usd(67)},
+	{usd(100), 2, 3, decimal.RoundDown, usd(66)},
+	{usd(-100), 2, 3, decimal.RoundFloor, usd(-67)},
+	{usd(10), 1, 4, decimal.RoundHalfEven, usd(2)},
+	{usd(10), 1, 4, decimal.RoundHalfUp, usd(3)},
+	{Money{}, 1, 4, decimal.RoundHalfUp, Money{}},
+}
+
+func TestMulDivRound(t *testing.T) {
+	for i, test := range mulDivRoundTests {
+		r := test.m.MulDivRound(decimal.FromI64(test.num), decimal.FromI64(test.den), test.mode)
+		if r != test.out {
+			t.Errorf("[%d] expected %s got %s", i, test.out, r)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package money
 
 import (
+	"encoding/binary"
 	"fmt"
-	"strings"
+	"math"
+	"math/bits"
+	"unicode/utf8"
 
 	"github.com/zenazn/money/currency"
 	"github.com/zenazn/money/decimal"
@@ -63,47 +66,146 @@ var ten = decimal.NewRate(10 * 1000000)
 // separate the whole part from the fractional part, and without thousands
 // separators or other adornments) and the currency as an ISO 4217 currency
 // code, and returns a Money representing that value, or an error if either the
-// amount or currency fails to parse.
+// amount or currency fails to parse or the amount is too large to represent.
+// Parse doesn't allocate unless it returns an error.
 func Parse(amt, ccy string) (Money, error) {
 	c, err := currency.FromISOSymbol(ccy)
 	if err != nil {
 		return Money{}, err
 	}
 
-	sf := int(c.Units().MajorUnitScalingFactorExponent)
-	d := decimal.Decimal{}
-	dot := -1
-
-	// Classic multiply-by-10-and-add parser. I'm sure we could make this
-	// much faster if we wanted.
-	for i, chr := range amt {
-		if dot >= 0 && i-dot >= sf {
-			return Money{}, fmt.Errorf("money: too precise")
+	a := newAmountScanner(c)
+	for i := 0; i < len(amt); i++ {
+		if st := a.scan(i, amt[i]); st != scanOK {
+			chr, _ := utf8.DecodeRuneInString(amt[i:])
+			return Money{}, st.err(i, chr)
 		}
+	}
+	return a.finish(len(amt))
+}
+
+// ParseBytes is like Parse, but takes its arguments as byte slices, for
+// instance to parse values directly out of a network buffer without first
+// converting them to strings.
+func ParseBytes(amt, ccy []byte) (Money, error) {
+	c, err := currency.FromISOSymbol(string(ccy))
+	if err != nil {
+		return Money{}, err
+	}
 
-		if chr == '.' && i != 0 && dot == -1 {
-			dot = i + 1
-			continue
-		} else if chr < '0' || chr > '9' {
-			return Money{}, fmt.Errorf("money: bad char %q at position %d", chr, i)
+	a := newAmountScanner(c)
+	for i := 0; i < len(amt); i++ {
+		if st := a.scan(i, amt[i]); st != scanOK {
+			chr, _ := utf8.DecodeRune(amt[i:])
+			return Money{}, st.err(i, chr)
 		}
-		d = d.Mul(ten).Add(decimal.FromI64(int64(chr - '0')))
 	}
+	return a.finish(len(amt))
+}
+
+// amountScanner accumulates the digits of an amount one byte at a time. The
+// digits are accumulated in a uint64 for as long as they fit, which is the
+// common case, and as a 128-bit integer after that. An amount too large for a
+// Decimal is only reported once it has been scanned, so that malformed amounts
+// are reported as such regardless of their size.
+type amountScanner struct {
+	ccy      currency.Currency
+	sf       int
+	hi, lo   uint64
+	overflow bool
+	// dot is the index just past the decimal point, or -1 if there hasn't
+	// been one yet.
+	dot int
+}
+
+type scanStatus int
+
+const (
+	scanOK scanStatus = iota
+	scanTooPrecise
+	scanBadChar
+)
+
+// err returns the error for a status other than scanOK, which occurred at the
+// character chr at index i.
+func (st scanStatus) err(i int, chr rune) error {
+	if st == scanTooPrecise {
+		return fmt.Errorf("money: too precise")
+	}
+	return fmt.Errorf("money: bad char %q at position %d", chr, i)
+}
 
-	if dot == len(amt) {
+func newAmountScanner(c currency.Currency) amountScanner {
+	return amountScanner{ccy: c, sf: int(c.Units().MajorUnitScalingFactorExponent), dot: -1}
+}
+
+// scan consumes the byte chr at index i of the amount.
+func (a *amountScanner) scan(i int, chr byte) scanStatus {
+	if a.dot >= 0 && i-a.dot >= a.sf {
+		return scanTooPrecise
+	}
+	if chr == '.' && i != 0 && a.dot == -1 {
+		a.dot = i + 1
+		return scanOK
+	} else if chr < '0' || chr > '9' {
+		return scanBadChar
+	}
+	if a.hi == 0 && a.lo <= (math.MaxUint64-9)/10 {
+		a.lo = a.lo*10 + uint64(chr-'0')
+	} else {
+		a.mulAdd(10, uint64(chr-'0'))
+	}
+	return scanOK
+}
+
+// mulAdd sets the accumulated value to value*m + n, or records that it
+// overflowed.
+func (a *amountScanner) mulAdd(m, n uint64) {
+	h1, lo := bits.Mul64(a.lo, m)
+	h2, l2 := bits.Mul64(a.hi, m)
+	lo, c := bits.Add64(lo, n, 0)
+	hi, c1 := bits.Add64(h1, l2, c)
+	if h2 != 0 || c1 != 0 || hi>>63 != 0 {
+		a.overflow = true
+	}
+	a.hi, a.lo = hi, lo
+}
+
+// uint64Pow10 contains the powers of ten that fit in a uint64.
+var uint64Pow10 = [...]uint64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// finish returns the value of an amount of length n, all of which has been
+// scanned.
+func (a *amountScanner) finish(n int) (Money, error) {
+	if a.dot == n {
 		// If we saw a dot at the very end, that's malformed
 		return Money{}, fmt.Errorf("money: trailing dot")
-	} else if dot == -1 {
+	} else if a.dot == -1 {
 		// If we never saw a dot, that's equivalent to it being at the end
-		dot = len(amt)
+		a.dot = n
 	}
 
 	// Scale out to the scale factor
-	for i := len(amt) - dot; i < sf; i++ {
-		d = d.Mul(ten)
+	for i := a.sf - (n - a.dot); i > 0; {
+		e := i
+		if e >= len(uint64Pow10) {
+			e = len(uint64Pow10) - 1
+		}
+		a.mulAdd(uint64Pow10[e], 0)
+		i -= e
+	}
+	if a.overflow {
+		return Money{}, fmt.Errorf("money: amount too large")
 	}
 
-	return Money{d, c}, nil
+	// Decimal's halves aren't exported, but its little-endian encoding is
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[0:8], a.lo)
+	binary.LittleEndian.PutUint64(buf[8:16], a.hi)
+	return Money{decimal.ReadDecimal(buf[:]), a.ccy}, nil
 }
 
 // Amount returns a decimal integer number of minimum-representable-units of the
@@ -161,42 +263,135 @@ func (m Money) compatCcy(o Money) currency.Currency {
 // String renders the amount as a human-readable currency and amount without
 // loss of precision, like "EUR 1.30", "JPY 990", or "USD 0.0187".
 func (m Money) String() string {
-	if m.ccy == nil {
-		return "0"
-	}
+	var buf [48]byte
+	return string(m.appendText(buf[:0]))
+}
+
+// AppendText appends the value, as rendered by String, to b and returns the
+// extended buffer. It implements encoding.TextAppender, and never returns an
+// error. It doesn't allocate if b has enough capacity.
+func (m Money) AppendText(b []byte) ([]byte, error) {
+	return m.appendText(b), nil
+}
+
+// digits holds the decimal digits of the absolute value of an amount, split into
+// its whole and fractional parts. Parts are kept as offsets into buf rather than
+// slices of it, so that a digits on the stack stays there.
+type digits struct {
+	buf [40]byte
+	neg bool
+	// The digits before the point are buf[start:point], or "0" if that is
+	// empty, and those after it are pad zeros followed by buf[point:end].
+	start, point, end, pad int
+}
 
-	s := m.amt.String()
-	var prefix string
-	if s[0] == '-' {
-		s = s[1:]
-		prefix = m.ccy.Symbol() + " -"
+// split splits the digits of amt, which is scaled by 10**sf.
+func (d *digits) split(amt decimal.Decimal, sf int) {
+	d.end = len(amt.AppendString(d.buf[:0]))
+	d.start, d.neg = 0, d.buf[0] == '-'
+	if d.neg {
+		d.start = 1
+	}
+	if d.end-d.start > sf {
+		d.point, d.pad = d.end-sf, 0
 	} else {
-		prefix = m.ccy.Symbol() + " "
+		d.point, d.pad = d.start, sf-(d.end-d.start)
+	}
+}
+
+// appendWhole appends the digits before the point.
+func (d *digits) appendWhole(b []byte) []byte {
+	if d.point == d.start {
+		return append(b, '0')
+	}
+	return append(b, d.buf[d.start:d.point]...)
+}
+
+// fracDigit returns the ith digit after the point.
+func (d *digits) fracDigit(i int) byte {
+	if i < d.pad {
+		return '0'
+	}
+	return d.buf[d.point+i-d.pad]
+}
+
+// appendFrac appends the point and the first n digits after it, followed by
+// extra zeros, unless there are no digits to append.
+func (d *digits) appendFrac(b []byte, n, extra int) []byte {
+	if n+extra == 0 {
+		return b
+	}
+	b = append(b, '.')
+	for i := 0; i < n; i++ {
+		b = append(b, d.fracDigit(i))
+	}
+	for i := 0; i < extra; i++ {
+		b = append(b, '0')
 	}
+	return b
+}
 
+func (m Money) appendText(b []byte) []byte {
+	if m.ccy == nil {
+		return append(b, '0')
+	}
 	u := m.ccy.Units()
-	sf := int(u.MajorUnitScalingFactorExponent)
+	sf, minor := int(u.MajorUnitScalingFactorExponent), int(u.MinorUnitsInMajorUnitExponent)
 
-	if len(s) > sf {
-		s = s[:len(s)-sf] + "." + s[len(s)-sf:]
-	} else if len(s) == sf {
-		s = "0." + s
-	} else {
-		s = "0." + strings.Repeat("0", sf-len(s)) + s
+	var d digits
+	d.split(m.amt, sf)
+	b = append(b, m.ccy.Symbol()...)
+	b = append(b, ' ')
+	if d.neg {
+		b = append(b, '-')
 	}
+	b = d.appendWhole(b)
 
-	walkback := sf - int(u.MinorUnitsInMajorUnitExponent)
-	// Special case: for currencies without minor units, also consume the
-	// decimal point
-	if u.MinorUnitsInMajorUnitExponent == 0 {
-		walkback = walkback + 1
+	// Omit trailing zeros beyond the currency's minor units
+	n := sf
+	for n > minor && d.fracDigit(n-1) == '0' {
+		n--
 	}
+	return d.appendFrac(b, n, 0)
+}
 
-	for i := 0; i < walkback; i++ {
-		if s[len(s)-i-1] != '0' && s[len(s)-i-1] != '.' {
-			return prefix + s[:len(s)-i]
+// AppendFormat appends the value to b, formatted according to fmt, and returns
+// the extended buffer. The formats are those of the corresponding verbs of
+// Format: 's' renders the value like String, 'd' renders its amount as an
+// integer number of minimum-representable-units, and 'f' renders its amount in
+// major units with prec digits after the point, rounded using Bankers Rounding.
+// A prec of -1 uses the number of digits of the currency's minor units. Unlike
+// Format, AppendFormat doesn't allocate if b has enough capacity.
+func (m Money) AppendFormat(b []byte, fmt byte, prec int) []byte {
+	switch fmt {
+	case 's':
+		return m.appendText(b)
+	case 'd':
+		return m.amt.AppendString(b)
+	case 'f':
+		var sf, minor int
+		if m.ccy != nil {
+			u := m.ccy.Units()
+			sf, minor = int(u.MajorUnitScalingFactorExponent), int(u.MinorUnitsInMajorUnitExponent)
+		}
+		if prec < 0 {
+			prec = minor
+		}
+		amt := m.amt
+		if prec < sf {
+			amt = amt.Round(sf-prec, decimal.RoundHalfEven)
 		}
-	}
 
-	return prefix + s[:len(s)-walkback]
+		var d digits
+		d.split(amt, sf)
+		if d.neg {
+			b = append(b, '-')
+		}
+		b = d.appendWhole(b)
+		if prec < sf {
+			return d.appendFrac(b, prec, 0)
+		}
+		return d.appendFrac(b, sf, prec-sf)
+	}
+	return append(b, '%', fmt)
 }
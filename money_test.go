@@ -1,6 +1,10 @@
 package money
 
 import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/zenazn/money/currency"
@@ -58,6 +62,195 @@ func TestParseFailures(t *testing.T) {
 	}
 }
 
+var parseBytesTests = []struct {
+	amt, ccy string
+	v        string
+	err      string
+}{
+	{"1234.56", "USD", "USD 1234.56", ""},
+	{"0.000023", "USD", "USD 0.000023", ""},
+	{"", "JPY", "JPY 0", ""},
+	{"18446744073709551616", "USD", "USD 18446744073709551616.00", ""},
+	{"170141183460469231731687303715884.105727", "USD", "USD 170141183460469231731687303715884.105727", ""},
+	{"170141183460469231731687303715884.105728", "USD", "", "money: amount too large"},
+	{"170141183460469231731687303715885", "USD", "", "money: amount too large"},
+	{"1000000000000000000000000000000000000000", "USD", "", "money: amount too large"},
+	{"12.3456789", "USD", "", "money: too precise"},
+	{"12,5", "USD", "", "money: bad char ',' at position 2"},
+	{"12\u20ac", "USD", "", "money: bad char '\u20ac' at position 2"},
+	{"12\xff", "USD", "", "money: bad char '\ufffd' at position 2"},
+	{"12.", "USD", "", "money: trailing dot"},
+	{"12", "usd", "", "currency: no such ISO currency"},
+}
+
+func TestParseBytes(t *testing.T) {
+	for i, test := range parseBytesTests {
+		for _, parse := range []func(amt, ccy string) (Money, error){
+			Parse,
+			func(amt, ccy string) (Money, error) {
+				return ParseBytes([]byte(amt), []byte(ccy))
+			},
+		} {
+			v, err := parse(test.amt, test.ccy)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Errorf("[%d] expected error %q got %v", i, test.err, err)
+				}
+			} else if err != nil {
+				t.Errorf("[%d] unexpected error %v", i, err)
+			} else if v.String() != test.v {
+				t.Errorf("[%d] expected %q got %q", i, test.v, v)
+			}
+		}
+	}
+}
+
+// mulTenParse is the original implementation of Parse, which multiplies the
+// value by ten for every digit. It silently wraps (or panics) when the value
+// overflows a Decimal.
+func mulTenParse(amt, ccy string) (Money, error) {
+	c, err := currency.FromISOSymbol(ccy)
+	if err != nil {
+		return Money{}, err
+	}
+
+	sf := int(c.Units().MajorUnitScalingFactorExponent)
+	d := decimal.Decimal{}
+	dot := -1
+	for i, chr := range amt {
+		if dot >= 0 && i-dot >= sf {
+			return Money{}, fmt.Errorf("money: too precise")
+		}
+
+		if chr == '.' && i != 0 && dot == -1 {
+			dot = i + 1
+			continue
+		} else if chr < '0' || chr > '9' {
+			return Money{}, fmt.Errorf("money: bad char %q at position %d", chr, i)
+		}
+		d = d.Mul(ten).Add(decimal.FromI64(int64(chr - '0')))
+	}
+
+	if dot == len(amt) {
+		return Money{}, fmt.Errorf("money: trailing dot")
+	} else if dot == -1 {
+		dot = len(amt)
+	}
+	for i := len(amt) - dot; i < sf; i++ {
+		d = d.Mul(ten)
+	}
+	return Money{d, c}, nil
+}
+
+// exactAmount returns the amount, which mulTenParse accepted, as a big.Int in
+// units of 10**-6.
+func exactAmount(amt string) *big.Int {
+	scale := 6
+	if i := strings.IndexByte(amt, '.'); i >= 0 {
+		scale -= len(amt) - i - 1
+		amt = amt[:i] + amt[i+1:]
+	}
+	v, _ := new(big.Int).SetString("0"+amt, 10)
+	return v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+}
+
+func randomAmount(r *rand.Rand) string {
+	const chars = "0123456789012345678901234567890123456789...,-+e \xff\u20ac"
+	b := make([]byte, r.Intn(45))
+	for i := range b {
+		if r.Intn(8) == 0 {
+			b[i] = chars[r.Intn(len(chars))]
+		} else {
+			b[i] = '0' + byte(r.Intn(10))
+		}
+	}
+	return string(b)
+}
+
+var maxAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+// checkParse checks that Parse, ParseBytes and mulTenParse agree about the
+// amount and currency, except where mulTenParse overflows.
+func checkParse(t *testing.T, amt, ccy string) {
+	var want Money
+	var wantErr error
+	panicked := false
+	func() {
+		defer func() { panicked = recover() != nil }()
+		want, wantErr = mulTenParse(amt, ccy)
+	}()
+
+	got, err := Parse(amt, ccy)
+	gotBytes, errBytes := ParseBytes([]byte(amt), []byte(ccy))
+	if got != gotBytes || fmt.Sprint(err) != fmt.Sprint(errBytes) {
+		t.Fatalf("%q %q: Parse returned %v, %v but ParseBytes returned %v, %v", amt, ccy, got, err, gotBytes, errBytes)
+	}
+	if panicked {
+		// mulTenParse overflowed, and gave up before it could find any
+		// other error
+		if err == nil {
+			t.Fatalf("%q %q: expected error got %v", amt, ccy, got)
+		}
+	} else if wantErr == nil && exactAmount(amt).Cmp(maxAmount) > 0 {
+		// mulTenParse overflowed and silently wrapped around
+		if err == nil || err.Error() != "money: amount too large" {
+			t.Fatalf("%q %q: expected overflow got %v, %v", amt, ccy, got, err)
+		}
+	} else if got != want || fmt.Sprint(err) != fmt.Sprint(wantErr) {
+		t.Fatalf("%q %q: expected %v, %v got %v, %v", amt, ccy, want, wantErr, got, err)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"1.23", "0.000023", "1234"} {
+		f.Add(s, "USD")
+	}
+	for _, s := range parseFailureTests {
+		f.Add(s, "USD")
+	}
+	for _, test := range parseBytesTests {
+		f.Add(test.amt, test.ccy)
+	}
+	f.Fuzz(checkParse)
+}
+
+// TestParseMatchesMulTen complements FuzzParse's seeds with long random amounts,
+// many of which overflow.
+func TestParseMatchesMulTen(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ccys := []string{"USD", "JPY", "BHD", "BYR", "XXX", "usd", ""}
+	for i := 0; i < 100000; i++ {
+		amt, ccy := randomAmount(r), ccys[r.Intn(len(ccys))]
+		if r.Intn(4) == 0 {
+			// Keep the amount valid, but possibly very large
+			amt = strings.Map(func(c rune) rune {
+				if c < '0' || c > '9' {
+					return -1
+				}
+				return c
+			}, amt)
+		}
+		checkParse(t, amt, ccy)
+	}
+}
+
+func TestParseAllocations(t *testing.T) {
+	amt, ccy := []byte("26499352303014264292828635027055.993969"), []byte("USD")
+	if n := testing.AllocsPerRun(100, func() { Parse("1234.56", "USD") }); n != 0 {
+		t.Errorf("Parse: expected 0 allocations got %v", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { ParseBytes(amt, ccy) }); n != 0 {
+		t.Errorf("ParseBytes: expected 0 allocations got %v", n)
+	}
+}
+
+func BenchmarkParseMulTen(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mulTenParse("1234.56", "USD")
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -65,6 +258,13 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParseMulTenLarge(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mulTenParse("26499352303014264292828635027055.993969", "USD")
+	}
+}
+
 func BenchmarkParseLarge(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -72,6 +272,14 @@ func BenchmarkParseLarge(b *testing.B) {
 	}
 }
 
+func BenchmarkParseBytes(b *testing.B) {
+	amt, ccy := []byte("1234.56"), []byte("USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParseBytes(amt, ccy)
+	}
+}
+
 var basicTests = []struct {
 	m    Money
 	ccy  currency.Currency
@@ -147,5 +355,148 @@ func TestString(t *testing.T) {
 		if s := test.v.String(); s != test.s {
 			t.Errorf("[%d] expected %q got %q", i, test.s, s)
 		}
+		if b, err := test.v.AppendText([]byte("x=")); err != nil || string(b) != "x="+test.s {
+			t.Errorf("[%d] expected %q got %q (%v)", i, "x="+test.s, b, err)
+		}
+	}
+}
+
+// concatString is the original implementation of String, which builds the
+// string by concatenation. It is kept to check and benchmark String against.
+func concatString(m Money) string {
+	if m.ccy == nil {
+		return "0"
+	}
+
+	s := m.amt.String()
+	var prefix string
+	if s[0] == '-' {
+		s = s[1:]
+		prefix = m.ccy.Symbol() + " -"
+	} else {
+		prefix = m.ccy.Symbol() + " "
+	}
+
+	u := m.ccy.Units()
+	sf := int(u.MajorUnitScalingFactorExponent)
+
+	if len(s) > sf {
+		s = s[:len(s)-sf] + "." + s[len(s)-sf:]
+	} else if len(s) == sf {
+		s = "0." + s
+	} else {
+		s = "0." + strings.Repeat("0", sf-len(s)) + s
+	}
+
+	walkback := sf - int(u.MinorUnitsInMajorUnitExponent)
+	if u.MinorUnitsInMajorUnitExponent == 0 {
+		walkback = walkback + 1
+	}
+
+	for i := 0; i < walkback; i++ {
+		if s[len(s)-i-1] != '0' && s[len(s)-i-1] != '.' {
+			return prefix + s[:len(s)-i]
+		}
+	}
+
+	return prefix + s[:len(s)-walkback]
+}
+
+func TestStringMatchesConcat(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ccys := []currency.Currency{currency.USD, currency.JPY, currency.BHD, fakeUSD{}, bitcoin{}}
+	for i := 0; i < 100000; i++ {
+		amt := decimal.FromI64(r.Int63() >> uint(r.Intn(63)))
+		if r.Intn(4) == 0 {
+			amt = amt.MulDiv(decimal.FromI64(r.Int63()), decimal.FromI64(1), 0, decimal.RoundDown)
+		}
+		if r.Intn(2) == 0 {
+			amt = amt.Neg()
+		}
+		m := New(amt, ccys[r.Intn(len(ccys))])
+		if s, e := m.String(), concatString(m); s != e {
+			t.Fatalf("%#v: expected %q got %q", m, e, s)
+		}
+	}
+}
+
+var appendFormatTests = []struct {
+	m    Money
+	fmt  byte
+	prec int
+	s    string
+}{
+	{FromMinorUnits(-1234, currency.MXN), 's', 0, "MXN -12.34"},
+	{FromMinorUnits(-1234, currency.MXN), 'd', 0, "-12340000"},
+	{FromMinorUnits(-1234, currency.MXN), 'f', -1, "-12.34"},
+	{FromMinorUnits(-1234, currency.MXN), 'f', 1, "-12.3"},
+	{FromMinorUnits(-1234, currency.MXN), 'f', 0, "-12"},
+	{FromMinorUnits(-1250, currency.MXN), 'f', 0, "-12"},
+	{FromMinorUnits(-1350, currency.MXN), 'f', 0, "-14"},
+	{FromMinorUnits(-1234, currency.MXN), 'f', 8, "-12.34000000"},
+	{New(decimal.FromI64(42), currency.CAD), 'f', -1, "0.00"},
+	{New(decimal.FromI64(42), currency.CAD), 'f', 6, "0.000042"},
+	{New(decimal.FromI64(12300000), currency.JPY), 'f', -1, "12"},
+	{New(decimal.FromI64(12500000), currency.JPY), 'f', -1, "12"},
+	{New(decimal.FromI64(12300000), currency.JPY), 'f', 2, "12.30"},
+	{Money{}, 'f', 2, "0.00"},
+	{Money{}, 's', 2, "0"},
+	{usd(1), 'x', 2, "%x"},
+}
+
+func TestAppendFormat(t *testing.T) {
+	for i, test := range appendFormatTests {
+		if s := string(test.m.AppendFormat(nil, test.fmt, test.prec)); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+}
+
+func TestAppendAllocations(t *testing.T) {
+	m := New(decimal.FromI64(1234567890123), currency.USD)
+	buf := make([]byte, 0, 64)
+	for _, f := range []func(){
+		func() { m.AppendText(buf[:0]) },
+		func() { m.AppendFormat(buf[:0], 's', -1) },
+		func() { m.AppendFormat(buf[:0], 'd', -1) },
+		func() { m.AppendFormat(buf[:0], 'f', 2) },
+	} {
+		if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+			t.Errorf("expected no allocations got %v", allocs)
+		}
+	}
+}
+
+func BenchmarkStringConcat(b *testing.B) {
+	m := New(decimal.FromI64(1234567890123), currency.USD)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = concatString(m)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	m := New(decimal.FromI64(1234567890123), currency.USD)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.String()
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	m := New(decimal.FromI64(1234567890123), currency.USD)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = m.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	m := New(decimal.FromI64(1234567890123), currency.USD)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = m.AppendFormat(buf[:0], 'f', 2)
 	}
 }
@@ -0,0 +1,325 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+// cashFlows is a series of cash flows of a single currency, each of which
+// occurs num/den years after the first.
+type cashFlows struct {
+	amts []decimal.Decimal
+	nums []int64
+	den  int64
+	ccy  currency.Currency
+}
+
+func newCashFlows(flows []Money, nums []int64, den int64) (cashFlows, error) {
+	cf := cashFlows{make([]decimal.Decimal, len(flows)), nums, den, nil}
+	for i, f := range flows {
+		if err := compat(cf.ccy, f.ccy); err != nil {
+			return cf, err
+		}
+		if cf.ccy == nil {
+			cf.ccy = f.ccy
+		}
+		cf.amts[i] = f.amt
+	}
+	return cf, nil
+}
+
+func periodic(flows []Money) (cashFlows, error) {
+	nums := make([]int64, len(flows))
+	for i := range nums {
+		nums[i] = int64(i)
+	}
+	return newCashFlows(flows, nums, 1)
+}
+
+func dated(flows []Money, dates []time.Time) (cashFlows, error) {
+	if len(flows) != len(dates) {
+		return cashFlows{}, errors.New("money: different numbers of cash flows and dates")
+	}
+	nums := make([]int64, len(flows))
+	for i, d := range dates {
+		nums[i] = civil(d) - civil(dates[0])
+		if nums[i] < 0 {
+			return cashFlows{}, fmt.Errorf("money: cash flow %d is dated before the first cash flow", i)
+		}
+	}
+	return newCashFlows(flows, nums, 365)
+}
+
+// npv returns the present value of the cash flows at the given rate, and its
+// derivative with respect to the rate (scaled by 1000000, so it's in units
+// per Rate). It returns false if either overflows.
+func (cf cashFlows) npv(rate decimal.Rate, deriv bool) (v, dv decimal.Decimal, ok bool) {
+	base := decimal.NewRate(1000000).Add(rate)
+	scale := decimal.FromI64(1000000 * cf.den)
+	for i, amt := range cf.amts {
+		pv, overflow := amt.MulPow(base, -cf.nums[i], cf.den)
+		if overflow {
+			return v, dv, false
+		}
+		if v, overflow = v.AddOverflow(pv); overflow {
+			return v, dv, false
+		}
+		if !deriv {
+			continue
+		}
+		// d/dr c*(1+r)**-t = -t*c*(1+r)**(-t-1)
+		d, overflow := amt.MulPow(base, -cf.nums[i]-cf.den, cf.den)
+		if overflow {
+			return v, dv, false
+		}
+		d = d.MulDiv(decimal.FromI64(-cf.nums[i]), scale, 0, decimal.RoundHalfEven)
+		if dv, overflow = dv.AddOverflow(d); overflow {
+			return v, dv, false
+		}
+	}
+	return v, dv, true
+}
+
+func (cf cashFlows) value(rate decimal.Rate) (Money, error) {
+	if rate.PPM() <= -1000000 {
+		return Money{}, errors.New("money: discount rate must be greater than -100%")
+	}
+	v, _, ok := cf.npv(rate, false)
+	if !ok {
+		return Money{}, errors.New("money: present value overflows")
+	}
+	return Money{v, cf.ccy}, nil
+}
+
+// NPV returns the net present value of the given cash flows, which occur at the
+// end of consecutive periods, discounted at the given rate per period. Unlike
+// some spreadsheets, NPV does not discount the first cash flow, which occurs at
+// the start of the first period. It returns an error if the cash flows have
+// different currencies, if the rate is not greater than -100%, or if the present
+// value overflows. The present value of each cash flow is computed with far
+// more precision than a Rate has, and rounded to the precision of its currency
+// before they are summed.
+func NPV(rate decimal.Rate, flows []Money) (Money, error) {
+	cf, err := periodic(flows)
+	if err != nil {
+		return Money{}, err
+	}
+	return cf.value(rate)
+}
+
+// XNPV returns the net present value of the given cash flows, which occur on the
+// given dates, discounted at the given annual rate. Like NPV, the first cash
+// flow is not discounted, and each subsequent cash flow is discounted by the
+// number of days since the first divided by 365. It returns an error under the
+// same conditions as NPV, and if a cash flow is dated before the first.
+func XNPV(rate decimal.Rate, flows []Money, dates []time.Time) (Money, error) {
+	cf, err := dated(flows, dates)
+	if err != nil {
+		return Money{}, err
+	}
+	return cf.value(rate)
+}
+
+// irrIterations is the maximum number of iterations of the IRR solver.
+const irrIterations = 100
+
+// irrBrackets are the rates at which IRR searches for a change in the sign of
+// the net present value. Each interval between them is further split into
+// irrSteps equal parts.
+var irrBrackets = []int64{
+	-999000, -990000, -950000, -900000, -750000, -500000, -250000, -100000,
+	0, 100000, 250000, 500000, 1000000, 2500000, 5000000, 10000000,
+	100000000, 1000000000,
+}
+
+// irrSteps is the number of parts each interval between irrBrackets is split
+// into.
+const irrSteps = 10
+
+// irrIntervals returns the intervals in which IRR searches for roots, ordered
+// by the magnitude of their end nearest zero.
+func irrIntervals() [][2]int64 {
+	var out [][2]int64
+	for i := 0; i+1 < len(irrBrackets); i++ {
+		lo, hi := irrBrackets[i], irrBrackets[i+1]
+		for j := int64(0); j < irrSteps; j++ {
+			out = append(out, [2]int64{lo + (hi-lo)*j/irrSteps, lo + (hi-lo)*(j+1)/irrSteps})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return nearZero(out[i]) < nearZero(out[j])
+	})
+	return out
+}
+
+// nearZero returns the distance from zero to the nearest end of the interval.
+func nearZero(in [2]int64) int64 {
+	if in[0] >= 0 {
+		return in[0]
+	} else if in[1] <= 0 {
+		return -in[1]
+	}
+	return 0
+}
+
+// irr finds the rate nearest zero at which the net present value of the cash
+// flows is zero.
+func (cf cashFlows) irr() (decimal.Rate, error) {
+	var pos, neg bool
+	for _, amt := range cf.amts {
+		pos = pos || amt.Sign() > 0
+		neg = neg || amt.Sign() < 0
+	}
+	if !pos || !neg {
+		return decimal.Rate{}, errors.New("money: IRR requires both positive and negative cash flows")
+	}
+
+	// The rate at which the net present value is zero doesn't depend on
+	// the scale of the cash flows, so scale them up as far as possible to
+	// compute it precisely.
+	one, ten := decimal.FromI64(1), decimal.FromI64(10)
+	limit := decimal.FromI64(1000000000000).MulDiv(decimal.FromI64(1000000000000), one, 0, decimal.RoundDown)
+	for {
+		scaled := cashFlows{make([]decimal.Decimal, len(cf.amts)), cf.nums, cf.den, cf.ccy}
+		fits := true
+		for i, amt := range cf.amts {
+			scaled.amts[i] = amt.MulDiv(ten, one, 0, decimal.RoundDown)
+			fits = fits && absLess(scaled.amts[i], limit)
+		}
+		if !fits {
+			break
+		}
+		cf = scaled
+	}
+
+	// Search outward from zero for intervals in which the sign of the net
+	// present value changes, and solve each one, until no interval left
+	// could hold a rate nearer zero than the nearest one found.
+	sign := func(ppm int64) (int, bool) {
+		v, _, ok := cf.npv(decimal.NewRate(ppm), false)
+		return v.Sign(), ok
+	}
+	var best int64
+	found := false
+	for _, in := range irrIntervals() {
+		if found && nearZero(in) >= abs64(best) {
+			break
+		}
+		a, aok := sign(in[0])
+		b, bok := sign(in[1])
+		if !aok || !bok || a*b > 0 {
+			continue
+		}
+		r, err := cf.solve(in[0], in[1], a, b)
+		if err != nil {
+			return decimal.Rate{}, err
+		}
+		if !found || abs64(r) < abs64(best) {
+			best, found = r, true
+		}
+	}
+	if !found {
+		return decimal.Rate{}, errors.New("money: IRR did not converge: no rate brackets a root")
+	}
+	return decimal.NewRate(best), nil
+}
+
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// solve finds a rate between lo and hi at which the net present value of the
+// cash flows is zero, given its signs at lo and hi, using Newton's method
+// safeguarded by bisection.
+func (cf cashFlows) solve(lo, hi int64, loSign, hiSign int) (int64, error) {
+	if loSign == 0 {
+		return lo, nil
+	} else if hiSign == 0 {
+		return hi, nil
+	}
+
+	x := lo + (hi-lo)/2
+	for i := 0; i < irrIterations; i++ {
+		if hi-lo <= 1 {
+			// Pick whichever rate has the smaller net present value
+			l, _, _ := cf.npv(decimal.NewRate(lo), false)
+			h, _, _ := cf.npv(decimal.NewRate(hi), false)
+			if absLess(h, l) {
+				return hi, nil
+			}
+			return lo, nil
+		}
+
+		v, dv, ok := cf.npv(decimal.NewRate(x), true)
+		if ok && v.Sign() == 0 {
+			return x, nil
+		}
+		if ok && v.Sign() == loSign {
+			lo = x
+		} else {
+			hi = x
+		}
+
+		// Take a Newton step if it stays within the bracket, and bisect
+		// otherwise.
+		next := lo + (hi-lo)/2
+		if ok && dv.Sign() != 0 {
+			step, fits := v.MulDiv(decimal.FromI64(1), dv, 0, decimal.RoundHalfEven).Int64()
+			if fits {
+				if step == 0 {
+					step = int64(v.Sign() * dv.Sign())
+				}
+				if n := x - step; lo < n && n < hi {
+					next = n
+				}
+			}
+		}
+		x = next
+	}
+	return 0, fmt.Errorf("money: IRR did not converge after %d iterations", irrIterations)
+}
+
+func absLess(a, b decimal.Decimal) bool {
+	if a.Sign() < 0 {
+		a = a.Neg()
+	}
+	if b.Sign() < 0 {
+		b = b.Neg()
+	}
+	return a.Lt(b)
+}
+
+// IRR returns the internal rate of return of the given cash flows, which occur
+// at the end of consecutive periods: the rate per period at which their NPV is
+// zero, to the nearest part per million. Cash flows may have more than one
+// internal rate of return, in which case IRR returns the one closest to zero.
+// It searches rates between -99.9% and 100,000% for changes in the sign of the
+// NPV, so it can miss two rates that are very close together.
+// It returns an error if the cash flows have different currencies, if they
+// aren't both positive and negative, or if the solver does not converge.
+func IRR(flows []Money) (decimal.Rate, error) {
+	cf, err := periodic(flows)
+	if err != nil {
+		return decimal.Rate{}, err
+	}
+	return cf.irr()
+}
+
+// XIRR returns the internal rate of return of the given cash flows, which occur
+// on the given dates: the annual rate at which their XNPV is zero. It returns an
+// error under the same conditions as IRR and XNPV.
+func XIRR(flows []Money, dates []time.Time) (decimal.Rate, error) {
+	cf, err := dated(flows, dates)
+	if err != nil {
+		return decimal.Rate{}, err
+	}
+	return cf.irr()
+}
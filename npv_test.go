@@ -0,0 +1,116 @@
+package money
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenazn/money/decimal"
+)
+
+func usds(dollars ...int64) []Money {
+	out := make([]Money, len(dollars))
+	for i, d := range dollars {
+		out[i] = usd(d * 100)
+	}
+	return out
+}
+
+// Microsoft's XIRR and XNPV example
+var xflows = usds(-10000, 2750, 4250, 3250, 2750)
+var xdates = []time.Time{
+	date(2008, 1, 1), date(2008, 3, 1), date(2008, 10, 30), date(2009, 2, 15), date(2009, 4, 1),
+}
+
+func TestNPV(t *testing.T) {
+	r, err := NPV(decimal.NewRate(100000), usds(-1000, 300, 400, 500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.String() != "USD -21.036815" {
+		t.Errorf("expected %q got %q", "USD -21.036815", r)
+	}
+
+	r, err = XNPV(decimal.NewRate(90000), xflows, xdates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.String() != "USD 2086.647601" {
+		t.Errorf("expected %q got %q", "USD 2086.647601", r)
+	}
+
+	if r, err = NPV(decimal.NewRate(100000), []Money{{}, usd(100)}); err != nil || r.String() != "USD 0.909091" {
+		t.Errorf("expected %q got %q (%v)", "USD 0.909091", r, err)
+	}
+	if r, err = NPV(decimal.NewRate(100000), nil); err != nil || r != (Money{}) {
+		t.Errorf("expected currencyless zero got %q (%v)", r, err)
+	}
+}
+
+func TestNPVErrors(t *testing.T) {
+	if _, err := NPV(decimal.NewRate(100000), []Money{usd(-100), cad(100)}); err == nil {
+		t.Error("expected error for mixed currencies")
+	}
+	if _, err := NPV(decimal.NewRate(-1000000), usds(-100, 100)); err == nil {
+		t.Error("expected error for -100% rate")
+	}
+	if _, err := NPV(decimal.NewRate(-999999), usds(-100, 100, 100, 100, 100, 100, 100, 100)); err == nil {
+		t.Error("expected overflow")
+	}
+	if _, err := XNPV(decimal.NewRate(100000), xflows, xdates[1:]); err == nil {
+		t.Error("expected error for mismatched dates")
+	}
+	backwards := []time.Time{date(2008, 1, 1), date(2007, 1, 1)}
+	if _, err := XNPV(decimal.NewRate(100000), usds(-100, 100), backwards); err == nil {
+		t.Error("expected error for dates before the first")
+	}
+}
+
+var irrTests = []struct {
+	flows []Money
+	irr   int64
+}{
+	{usds(-1000, 300, 400, 500), 88963},
+	{usds(-1000, 1100), 100000},
+	{usds(-1000, 0, 0, 1331), 100000},
+	{usds(1000, -1100), 100000},
+	{usds(-1000, 500), -500000},
+	{usds(-1000, 10, 10, 10), -765502},
+	{usds(-100, 230, -132), 100000},
+	{usds(-1, 10), 9000000},
+	{usds(1000, -2850, 1805), -50000},
+	{usds(1000, -1150, 105), 50000},
+	{usds(10000, -22600, 12768), 120000},
+}
+
+func TestIRR(t *testing.T) {
+	for i, test := range irrTests {
+		r, err := IRR(test.flows)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if r != decimal.NewRate(test.irr) {
+			t.Errorf("[%d] expected %d got %d", i, test.irr, r.PPM())
+		}
+	}
+
+	r, err := XIRR(xflows, xdates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != decimal.NewRate(373363) {
+		t.Errorf("expected 373363 got %d", r.PPM())
+	}
+}
+
+func TestIRRErrors(t *testing.T) {
+	for i, flows := range [][]Money{
+		nil,
+		usds(100, 100),
+		usds(-100, -100),
+		{usd(-100), cad(100)},
+		usds(-1, 100000000000),
+	} {
+		if _, err := IRR(flows); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zenazn/money/decimal"
+)
+
+// Proration determines how the time used of a billing period is measured.
+type Proration int
+
+const (
+	// ProrateDaily measures time in calendar days. Only the calendar dates
+	// of times are significant.
+	ProrateDaily Proration = iota
+	// ProrateSeconds measures time in whole seconds.
+	ProrateSeconds
+	// ProrateMonthly measures time in calendar months, where each day is
+	// worth one month divided by the number of days in its month. For
+	// instance, a day in February 2023 is worth 1/28 of a month, and a day
+	// in March is worth 1/31. Only the calendar dates of times are
+	// significant.
+	ProrateMonthly
+)
+
+var prorationNames = [...]string{"daily", "seconds", "monthly"}
+
+func (p Proration) String() string {
+	if p < 0 || int(p) >= len(prorationNames) {
+		return fmt.Sprintf("Proration(%d)", int(p))
+	}
+	return prorationNames[p]
+}
+
+// Period is the span of time from Start up to, but not including, End.
+type Period struct {
+	Start, End time.Time
+}
+
+// NewPeriod returns the Period of the given duration that starts at the given
+// time.
+func NewPeriod(start time.Time, d time.Duration) Period {
+	return Period{start, start.Add(d)}
+}
+
+// daysPerMonth is divisible by the number of days in every month.
+const daysPerMonth = 28 * 29 * 30 * 31 / 2
+
+// position returns the time elapsed between an arbitrary epoch and t, in
+// units of the given proration.
+func (p Proration) position(t time.Time) (int64, error) {
+	switch p {
+	case ProrateDaily:
+		return civil(t), nil
+	case ProrateSeconds:
+		return t.Unix(), nil
+	case ProrateMonthly:
+		y, m, d := t.Date()
+		days := int64(time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day())
+		months := int64(y)*12 + int64(m-1)
+		return months*daysPerMonth + int64(d-1)*(daysPerMonth/days), nil
+	}
+	return 0, fmt.Errorf("money: unknown proration %d", int(p))
+}
+
+// units returns the units that the period from start to end is measured in, as
+// runs of consecutive units of the same length: the position at which each run
+// starts, the length of its units, and how many of them there are.
+func (p Proration) units(start, end time.Time) (starts, lengths, counts []int64) {
+	from, _ := p.position(start)
+	to, _ := p.position(end)
+	if p != ProrateMonthly {
+		return []int64{from}, []int64{1}, []int64{to - from}
+	}
+
+	// Each day is worth a fraction of its month, so every month is a run
+	y, m, _ := start.Date()
+	for pos := from; pos < to; m++ {
+		days := int64(time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day())
+		next := (int64(y)*12 + int64(m)) * daysPerMonth
+		if next > to {
+			next = to
+		}
+		starts = append(starts, pos)
+		lengths = append(lengths, daysPerMonth/days)
+		counts = append(counts, (next-pos)/(daysPerMonth/days))
+		pos = next
+	}
+	return starts, lengths, counts
+}
+
+// Prorate returns the part of the price of a billing period that is due for the
+// part of it that was used. To prorate a duration used from the start of the
+// period, use NewPeriod to construct the used Period.
+//
+// Prorate first rounds the price to minor units using the given rounding mode.
+// It then splits it into a part for each day, second or (for ProrateMonthly)
+// weighted day of the period, exactly like Allocate would, and returns the sum
+// of the parts of the time used. As a result, the prorated prices of
+// consecutive segments of a period always sum to the rounded price of the whole
+// period, and the prorated prices of each unit of a period are the parts that
+// Allocate returns for them. For instance, prorating USD 1.00 over three days
+// one day at a time gives USD 0.34, 0.33 and 0.33. Since the minor units that
+// can't be split evenly go to the earliest units, a price worth less than a
+// minor unit per unit, such as USD 30.00 prorated by the second over a month,
+// is due entirely in the first units of the period.
+//
+// Prorate returns an error if the period is empty, or if the used time doesn't
+// fall within it.
+func Prorate(price Money, period, used Period, p Proration, mode decimal.RoundingMode) (Money, error) {
+	var pos [4]int64
+	for i, t := range []time.Time{period.Start, used.Start, used.End, period.End} {
+		var err error
+		if pos[i], err = p.position(t); err != nil {
+			return Money{}, err
+		}
+	}
+	if pos[3] <= pos[0] {
+		return Money{}, errors.New("money: empty proration period")
+	}
+	for i := 1; i < len(pos); i++ {
+		if pos[i] < pos[i-1] {
+			return Money{}, errors.New("money: used time is outside the proration period")
+		}
+	}
+	if price.ccy == nil {
+		return price, nil
+	}
+
+	starts, lengths, counts := p.units(period.Start, period.End)
+	weights := make([]decimal.Decimal, len(lengths))
+	for i, l := range lengths {
+		weights[i] = decimal.FromI64(l)
+	}
+	exp := minorExp(price.ccy)
+	amt := price.amt.Round(exp, mode)
+	parts, extra := amt.AllocateRuns(weights, counts, exp)
+	unit := pow10(exp)
+	if amt.Sign() < 0 {
+		unit = unit.Neg()
+	}
+
+	// Add up the units of each run that were used, the first extra[i] of
+	// which got another minor unit
+	one := decimal.FromI64(1)
+	var sum decimal.Decimal
+	for i, start := range starts {
+		first := (pos[1] - start) / lengths[i]
+		last := (pos[2] - start) / lengths[i]
+		if first < 0 {
+			first = 0
+		}
+		if last > counts[i] {
+			last = counts[i]
+		}
+		if first >= last {
+			continue
+		}
+		sum = sum.Add(parts[i].MulDiv(decimal.FromI64(last-first), one, 0, decimal.RoundDown))
+		if extra[i] < last {
+			last = extra[i]
+		}
+		if n := last - first; n > 0 {
+			sum = sum.Add(unit.MulDiv(decimal.FromI64(n), one, 0, decimal.RoundDown))
+		}
+	}
+	return Money{sum, price.ccy}, nil
+}
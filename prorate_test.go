@@ -0,0 +1,164 @@
+package money
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/zenazn/money/decimal"
+)
+
+var prorateTests = []struct {
+	price        Money
+	period, used Period
+	p            Proration
+	mode         decimal.RoundingMode
+	s            string
+}{
+	{usd(3000), Period{date(2023, 1, 1), date(2023, 2, 1)}, Period{date(2023, 1, 1), date(2023, 1, 11)}, ProrateDaily, decimal.RoundHalfEven, "USD 9.70"},
+	{usd(3000), Period{date(2023, 1, 1), date(2023, 2, 1)}, Period{date(2023, 1, 1), date(2023, 1, 11)}, ProrateDaily, decimal.RoundDown, "USD 9.70"},
+	{usd(3000), Period{date(2023, 1, 1), date(2023, 2, 1)}, Period{date(2023, 1, 11), date(2023, 2, 1)}, ProrateDaily, decimal.RoundDown, "USD 20.30"},
+	{usd(360050), NewPeriod(date(2023, 1, 1), time.Hour), NewPeriod(date(2023, 1, 1), 30*time.Minute), ProrateSeconds, decimal.RoundHalfEven, "USD 1800.50"},
+	{usd(3000), Period{date(2023, 1, 1), date(2023, 1, 31)}, NewPeriod(date(2023, 1, 1), 36*time.Hour), ProrateSeconds, decimal.RoundHalfEven, "USD 30.00"},
+	{usd(3000), Period{date(2023, 1, 1), date(2023, 1, 31)}, NewPeriod(date(2023, 1, 1), 36*time.Hour), ProrateDaily, decimal.RoundHalfEven, "USD 1.00"},
+	{usd(10000), Period{date(2023, 1, 15), date(2023, 2, 15)}, Period{date(2023, 1, 15), date(2023, 2, 1)}, ProrateMonthly, decimal.RoundHalfEven, "USD 52.36"},
+	{usd(10000), Period{date(2023, 1, 15), date(2023, 2, 15)}, Period{date(2023, 2, 1), date(2023, 2, 15)}, ProrateMonthly, decimal.RoundHalfEven, "USD 47.64"},
+	{usd(10000), Period{date(2023, 1, 1), date(2023, 4, 1)}, Period{date(2023, 2, 1), date(2023, 3, 1)}, ProrateMonthly, decimal.RoundHalfEven, "USD 33.32"},
+	{usd(10000), Period{date(2023, 1, 1), date(2023, 4, 1)}, Period{date(2023, 2, 1), date(2023, 2, 1)}, ProrateMonthly, decimal.RoundHalfEven, "USD 0.00"},
+}
+
+func TestProrate(t *testing.T) {
+	for i, test := range prorateTests {
+		m, err := Prorate(test.price, test.period, test.used, test.p, test.mode)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if s := m.String(); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+}
+
+func TestProrateSegments(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	period := Period{date(2024, 1, 15), date(2024, 4, 15)}
+	length := period.End.Sub(period.Start)
+	for _, p := range []Proration{ProrateDaily, ProrateSeconds, ProrateMonthly} {
+		for _, mode := range []decimal.RoundingMode{decimal.RoundHalfEven, decimal.RoundUp, decimal.RoundDown} {
+			for i := 0; i < 20; i++ {
+				price := usd(r.Int63n(100000))
+				var sum Money
+				start := period.Start
+				for start.Before(period.End) {
+					end := start.Add(time.Duration(r.Int63n(int64(length / 5))))
+					if end.After(period.End) {
+						end = period.End
+					}
+					m, err := Prorate(price, period, Period{start, end}, p, mode)
+					if err != nil {
+						t.Fatal(err)
+					}
+					sum = sum.Add(m)
+					start = end
+				}
+				if !sum.Eq(price) {
+					t.Errorf("%v/%d: segments of %v sum to %v", p, mode, price, sum)
+				}
+			}
+		}
+	}
+}
+
+func TestProrateModes(t *testing.T) {
+	period := Period{date(2023, 1, 1), date(2023, 1, 4)}
+	for _, test := range []struct {
+		mode decimal.RoundingMode
+		days [3]string
+	}{
+		{decimal.RoundDown, [3]string{"USD 0.34", "USD 0.33", "USD 0.33"}},
+		{decimal.RoundUp, [3]string{"USD 0.34", "USD 0.34", "USD 0.33"}},
+		{decimal.RoundHalfEven, [3]string{"USD 0.34", "USD 0.33", "USD 0.33"}},
+	} {
+		for i, want := range test.days {
+			used := Period{date(2023, 1, 1+i), date(2023, 1, 2+i)}
+			m, err := Prorate(mustparse("1.005", "USD"), period, used, ProrateDaily, test.mode)
+			if err != nil {
+				t.Errorf("%d: [%d] unexpected error: %v", test.mode, i, err)
+			} else if s := m.String(); s != want {
+				t.Errorf("%d: [%d] expected %q got %q", test.mode, i, want, s)
+			}
+		}
+	}
+}
+
+// Each unit of a period gets the part that Allocate gives it, and each segment
+// the sum of the parts of its units.
+func TestProrateMatchesAllocate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		p      Proration
+		period Period
+		unit   time.Duration
+	}{
+		{ProrateDaily, Period{date(2024, 1, 15), date(2024, 4, 15)}, 24 * time.Hour},
+		{ProrateMonthly, Period{date(2024, 1, 15), date(2024, 4, 15)}, 24 * time.Hour},
+		{ProrateSeconds, NewPeriod(date(2024, 1, 15), 2*time.Hour), time.Second},
+	} {
+		var units []time.Time
+		var weights []decimal.Decimal
+		for u := test.period.Start; u.Before(test.period.End); u = u.Add(test.unit) {
+			w := int64(1)
+			if test.p == ProrateMonthly {
+				w = daysPerMonth / int64(time.Date(u.Year(), u.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day())
+			}
+			units = append(units, u)
+			weights = append(weights, decimal.FromI64(w))
+		}
+		units = append(units, test.period.End)
+
+		for i := 0; i < 20; i++ {
+			price := usd(r.Int63n(2000000) - 1000000)
+			parts, err := price.Allocate(weights...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j := 0; j < len(parts); {
+				k := j + 1
+				if i > 0 {
+					k += r.Intn(len(parts) / 5)
+				}
+				if k > len(parts) {
+					k = len(parts)
+				}
+				var want Money
+				for _, part := range parts[j:k] {
+					want = want.Add(part)
+				}
+				m, err := Prorate(price, test.period, Period{units[j], units[k]}, test.p, decimal.RoundHalfEven)
+				if err != nil {
+					t.Fatal(err)
+				} else if !m.Eq(want) {
+					t.Errorf("%v: units %d to %d of %v are %v, but Allocate gives %v", test.p, j, k, price, m, want)
+				}
+				j = k
+			}
+		}
+	}
+}
+
+func TestProrateErrors(t *testing.T) {
+	period := Period{date(2023, 1, 1), date(2023, 2, 1)}
+	for i, test := range []struct {
+		period, used Period
+		p            Proration
+	}{
+		{Period{date(2023, 1, 1), date(2023, 1, 1)}, Period{date(2023, 1, 1), date(2023, 1, 1)}, ProrateDaily},
+		{period, Period{date(2022, 12, 31), date(2023, 1, 2)}, ProrateDaily},
+		{period, Period{date(2023, 1, 10), date(2023, 2, 2)}, ProrateMonthly},
+		{period, Period{date(2023, 1, 10), date(2023, 1, 9)}, ProrateSeconds},
+		{period, period, Proration(7)},
+	} {
+		if _, err := Prorate(usd(100), test.period, test.used, test.p, decimal.RoundHalfEven); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
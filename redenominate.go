@@ -0,0 +1,65 @@
+package money
+
+import (
+	"time"
+
+	"github.com/zenazn/money/cldr"
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+// Redenomination describes the replacement of a currency by a successor, each
+// unit of which is worth a power of ten units of the old currency.
+type Redenomination struct {
+	From, To currency.Currency
+	// Factor is the number of units of the old currency that each unit of
+	// its successor replaced.
+	Factor int64
+	// Date is the day the successor currency was introduced.
+	Date cldr.Date
+}
+
+// Redenominations contains the redenominations of the withdrawn currencies in
+// the currency package, keyed by the ISO 4217 code of the old currency.
+var Redenominations = map[string]Redenomination{}
+
+func init() {
+	for _, r := range []Redenomination{
+		{From: currency.BYR, To: currency.BYN, Factor: 10000, Date: cldr.Date{Year: 2016, Month: time.July, Day: 1}},
+		{From: currency.MRO, To: currency.MRU, Factor: 10, Date: cldr.Date{Year: 2018, Month: time.January, Day: 1}},
+		{From: currency.STD, To: currency.STN, Factor: 1000, Date: cldr.Date{Year: 2018, Month: time.January, Day: 1}},
+		{From: currency.VEF, To: currency.VES, Factor: 100000, Date: cldr.Date{Year: 2018, Month: time.August, Day: 20}},
+	} {
+		Redenominations[r.From.Symbol()] = r
+	}
+}
+
+// Redenominate converts a value in a currency that has since been
+// redenominated into its successor currency, if the successor had been
+// introduced at the given time. The value is divided by the official factor
+// and rounded to the minor units of the successor, rounding halves away from
+// zero as the official conversions did. For instance, VEF 123456.78 becomes VES
+// 1.23 from 20 August 2018.
+//
+// Values in other currencies, and values converted at times before their
+// successor was introduced, are returned unchanged, so reports can redenominate
+// every value they contain as of a common date.
+func Redenominate(m Money, asOf time.Time) Money {
+	if m.ccy == nil {
+		return m
+	}
+	r, ok := Redenominations[m.ccy.Symbol()]
+	if !ok || asOf.Before(r.Date.Time()) {
+		return m
+	}
+	// Convert between the scaling factors of the two currencies, which
+	// needn't be the same
+	num, den := decimal.FromI64(1), decimal.FromI64(r.Factor)
+	for i := r.To.Units().MajorUnitScalingFactorExponent; i > 0; i-- {
+		num = num.Mul(ten)
+	}
+	for i := m.ccy.Units().MajorUnitScalingFactorExponent; i > 0; i-- {
+		den = den.Mul(ten)
+	}
+	return Money{m.amt.MulDiv(num, den, minorExp(r.To), decimal.RoundHalfUp), r.To}
+}
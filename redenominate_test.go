@@ -0,0 +1,80 @@
+package money
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenazn/money/cldr"
+	"github.com/zenazn/money/currency"
+)
+
+func TestRedenominationDates(t *testing.T) {
+	for sym, d := range map[string]time.Time{
+		"BYR": date(2016, 7, 1),
+		"MRO": date(2018, 1, 1),
+		"STD": date(2018, 1, 1),
+		"VEF": date(2018, 8, 20),
+	} {
+		if r := Redenominations[sym]; !r.Date.Time().Equal(d) {
+			t.Errorf("%s: expected %v got %v", sym, d, r.Date.Time())
+		}
+	}
+}
+
+// The successor was introduced when the first region that used the old currency
+// started using it.
+func TestRedenominationDatesMatchCLDR(t *testing.T) {
+	for sym, r := range Redenominations {
+		var first cldr.Date
+		for _, usages := range cldr.RegionCurrencies {
+			old := false
+			for _, u := range usages {
+				old = old || u.Symbol == sym
+			}
+			for _, u := range usages {
+				if old && u.Symbol == r.To.Symbol() && (first.IsZero() || u.From.Time().Before(first.Time())) {
+					first = u.From
+				}
+			}
+		}
+		if first != r.Date {
+			t.Errorf("%s: CLDR introduces %s on %v, not %v", sym, r.To.Symbol(), first, r.Date)
+		}
+	}
+}
+
+var redenominateTests = []struct {
+	amt, ccy string
+	asOf     time.Time
+	s        string
+}{
+	{"123456.78", "VEF", date(2018, 8, 20), "VES 1.23"},
+	{"123456.78", "VEF", date(2018, 8, 19), "VEF 123456.78"},
+	{"1500", "VEF", date(2019, 1, 1), "VES 0.02"},
+	{"1499.99", "VEF", date(2019, 1, 1), "VES 0.01"},
+	{"1234.56", "MRO", date(2018, 1, 1), "MRU 123.46"},
+	{"1234.55", "MRO", date(2018, 1, 1), "MRU 123.46"},
+	{"1234.55", "MRO", date(2017, 12, 31), "MRO 1234.55"},
+	{"1000000", "STD", date(2020, 1, 1), "STN 1000.00"},
+	{"25000", "BYR", date(2016, 7, 1), "BYN 2.50"},
+	{"100.00", "VES", date(2020, 1, 1), "VES 100.00"},
+	{"100.00", "USD", date(2020, 1, 1), "USD 100.00"},
+}
+
+func TestRedenominate(t *testing.T) {
+	for i, test := range redenominateTests {
+		m := Redenominate(mustparse(test.amt, test.ccy), test.asOf)
+		if s := m.String(); s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+	if m := Redenominate(mustparse("1500", "VEF").Neg(), date(2019, 1, 1)); m.String() != "VES -0.02" {
+		t.Errorf("expected %q got %q", "VES -0.02", m)
+	}
+	if m := Redenominate(Money{}, date(2019, 1, 1)); m != (Money{}) {
+		t.Errorf("expected currencyless zero got %v", m)
+	}
+	if m := Redenominate(FromMinorUnits(100, currency.MRO), date(2019, 1, 1)); m.Currency() != currency.MRU {
+		t.Errorf("expected MRU got %v", m)
+	}
+}
@@ -0,0 +1,202 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zenazn/money/cldr"
+)
+
+// SpellOutStyle determines how SpellOut renders the minor units of an amount.
+type SpellOutStyle int
+
+const (
+	// SpellOutFraction renders minor units as a fraction, as is customary
+	// on cheques: "One hundred US dollars and 05/100".
+	SpellOutFraction SpellOutStyle = iota
+	// SpellOutWords renders minor units in words: "One hundred US dollars
+	// and five cents". Minor units are omitted if they are zero. For
+	// currencies whose minor units have no known name, SpellOutWords
+	// falls back to SpellOutFraction.
+	SpellOutWords
+)
+
+// spelling is a locale-independent description of an amount to spell out.
+type spelling struct {
+	negative bool
+	// major and minor are the decimal digits of the whole and fractional
+	// parts of the amount. minor has exactly minorDigits digits.
+	major, minor string
+	minorDigits  int
+	ccy          string
+	locale       string
+	style        SpellOutStyle
+}
+
+// spellers contains a function to spell out amounts for each supported
+// language.
+var spellers = map[string]func(s spelling) (string, error){
+	"en": spellEnglish,
+}
+
+// SpellOut renders the value in words, as is customary on cheques and in legal
+// documents, in the given CLDR locale: "One thousand two hundred thirty-four US
+// dollars and 56/100". Currency names come from the cldr package.
+//
+// Only English locales are currently supported. SpellOut returns an error if
+// the locale is not supported, if the value is a currencyless zero, or if the
+// value is more precise than the minor units of its currency.
+func SpellOut(m Money, locale string, style SpellOutStyle) (string, error) {
+	if m.ccy == nil {
+		return "", fmt.Errorf("money: cannot spell out a currencyless zero")
+	}
+	lang := strings.SplitN(strings.Replace(locale, "-", "_", -1), "_", 2)[0]
+	speller, ok := spellers[lang]
+	if !ok {
+		return "", fmt.Errorf("money: cannot spell out amounts in locale %q", locale)
+	}
+
+	s := m.amt.String()
+	sp := spelling{ccy: m.ccy.Symbol(), locale: locale, style: style}
+	if s[0] == '-' {
+		sp.negative = true
+		s = s[1:]
+	}
+
+	u := m.ccy.Units()
+	sf := int(u.MajorUnitScalingFactorExponent)
+	sp.minorDigits = int(u.MinorUnitsInMajorUnitExponent)
+	if len(s) <= sf {
+		s = strings.Repeat("0", sf-len(s)+1) + s
+	}
+	sp.major = s[:len(s)-sf]
+	frac := s[len(s)-sf:]
+	if strings.Trim(frac[sp.minorDigits:], "0") != "" {
+		return "", fmt.Errorf("money: %s is more precise than the minor units of %s", m, sp.ccy)
+	}
+	sp.minor = frac[:sp.minorDigits]
+
+	return speller(sp)
+}
+
+var englishOnes = [...]string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+	"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+	"sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var englishTens = [...]string{
+	"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+	"eighty", "ninety",
+}
+
+var englishScales = [...]string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion",
+	"quintillion", "sextillion", "septillion", "octillion", "nonillion",
+	"decillion", "undecillion", "duodecillion",
+}
+
+// englishMinorUnits contains the singular and plural English names of the minor
+// units of common currencies.
+var englishMinorUnits = map[string][2]string{
+	"AUD": {"cent", "cents"},
+	"BHD": {"fils", "fils"},
+	"BRL": {"centavo", "centavos"},
+	"CAD": {"cent", "cents"},
+	"CHF": {"centime", "centimes"},
+	"CNY": {"fen", "fen"},
+	"EUR": {"cent", "cents"},
+	"GBP": {"penny", "pence"},
+	"HKD": {"cent", "cents"},
+	"INR": {"paisa", "paise"},
+	"JOD": {"fils", "fils"},
+	"KWD": {"fils", "fils"},
+	"MXN": {"centavo", "centavos"},
+	"NZD": {"cent", "cents"},
+	"OMR": {"baisa", "baisa"},
+	"PLN": {"grosz", "groszy"},
+	"RUB": {"kopek", "kopeks"},
+	"SGD": {"cent", "cents"},
+	"TND": {"millime", "millimes"},
+	"USD": {"cent", "cents"},
+	"ZAR": {"cent", "cents"},
+}
+
+// englishInteger spells out a non-empty string of decimal digits.
+func englishInteger(digits string) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return englishOnes[0]
+	}
+
+	var words []string
+	ngroups := (len(digits) + 2) / 3
+	for g := 0; g < ngroups; g++ {
+		end := len(digits) - 3*(ngroups-g-1)
+		start := end - 3
+		if start < 0 {
+			start = 0
+		}
+		n := 0
+		for _, c := range digits[start:end] {
+			n = n*10 + int(c-'0')
+		}
+		if n == 0 {
+			continue
+		}
+		words = append(words, englishBelowThousand(n))
+		if scale := englishScales[ngroups-g-1]; scale != "" {
+			words = append(words, scale)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func englishBelowThousand(n int) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n = n % 100
+	}
+	if n >= 20 {
+		w := englishTens[n/10]
+		if n%10 != 0 {
+			w = w + "-" + englishOnes[n%10]
+		}
+		words = append(words, w)
+	} else if n > 0 {
+		words = append(words, englishOnes[n])
+	}
+	return strings.Join(words, " ")
+}
+
+func spellEnglish(s spelling) (string, error) {
+	name, err := cldr.DisplayName(s.locale, s.ccy, s.major)
+	if err != nil {
+		return "", err
+	}
+
+	var words []string
+	if s.negative {
+		words = append(words, "minus")
+	}
+	words = append(words, englishInteger(s.major), name)
+
+	units, hasUnits := englishMinorUnits[s.ccy]
+	if s.minorDigits == 0 {
+		// Nothing to add
+	} else if s.style == SpellOutWords && hasUnits {
+		if strings.Trim(s.minor, "0") != "" {
+			unit := units[1]
+			if strings.TrimLeft(s.minor, "0") == "1" {
+				unit = units[0]
+			}
+			words = append(words, "and", englishInteger(s.minor), unit)
+		}
+	} else {
+		words = append(words, "and", s.minor+"/1"+strings.Repeat("0", s.minorDigits))
+	}
+
+	out := strings.Join(words, " ")
+	return strings.ToUpper(out[:1]) + out[1:], nil
+}
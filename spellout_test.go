@@ -0,0 +1,56 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var spellOutTests = []struct {
+	m      Money
+	style  SpellOutStyle
+	locale string
+	s      string
+}{
+	{usd(123456), SpellOutFraction, "en", "One thousand two hundred thirty-four US dollars and 56/100"},
+	{usd(123456), SpellOutWords, "en", "One thousand two hundred thirty-four US dollars and fifty-six cents"},
+	{usd(100), SpellOutFraction, "en_US", "One US dollar and 00/100"},
+	{usd(100), SpellOutWords, "en-US", "One US dollar"},
+	{usd(101), SpellOutWords, "en", "One US dollar and one cent"},
+	{usd(5), SpellOutFraction, "en", "Zero US dollars and 05/100"},
+	{usd(0), SpellOutWords, "en", "Zero US dollars"},
+	{usd(-2050), SpellOutWords, "en", "Minus twenty US dollars and fifty cents"},
+	{usd(-2050), SpellOutFraction, "en", "Minus twenty US dollars and 50/100"},
+	{FromMinorUnits(1000000, currency.JPY), SpellOutFraction, "en", "One million Japanese yen"},
+	{FromMinorUnits(1011, currency.JPY), SpellOutWords, "en", "One thousand eleven Japanese yen"},
+	{FromMinorUnits(12500, currency.KWD), SpellOutFraction, "en", "Twelve Kuwaiti dinars and 500/1000"},
+	{FromMinorUnits(12500, currency.KWD), SpellOutWords, "en", "Twelve Kuwaiti dinars and five hundred fils"},
+	{FromMinorUnits(150, currency.CLF), SpellOutWords, "en", "Zero Chilean units of account (UF) and 0150/10000"},
+	{FromMinorUnits(2000000107, currency.GBP), SpellOutWords, "en_GB", "Twenty million one British pounds and seven pence"},
+	{FromMinorUnits(101, currency.GBP), SpellOutWords, "en", "One British pound and one penny"},
+	{New(decimal.FromI64(1000000000000000000), currency.USD), SpellOutFraction, "en", "One trillion US dollars and 00/100"},
+}
+
+func TestSpellOut(t *testing.T) {
+	for i, test := range spellOutTests {
+		s, err := SpellOut(test.m, test.locale, test.style)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+		} else if s != test.s {
+			t.Errorf("[%d] expected %q got %q", i, test.s, s)
+		}
+	}
+}
+
+func TestSpellOutErrors(t *testing.T) {
+	if _, err := SpellOut(Money{}, "en", SpellOutFraction); err == nil {
+		t.Error("currencyless zero")
+	}
+	if _, err := SpellOut(usd(100), "de", SpellOutFraction); err == nil {
+		t.Error("unsupported locale")
+	}
+	if _, err := SpellOut(mustparse("1.005", "USD"), "en", SpellOutFraction); err == nil {
+		t.Error("too precise")
+	}
+}
@@ -0,0 +1,180 @@
+// Package tax computes sales taxes, such as VAT and GST, on Money.
+//
+// Taxes are computed exactly and rounded once, to the minor units of the
+// price's currency, so results don't depend on the order of operations. Every
+// Breakdown returned by this package adds up: its net amount plus its taxes
+// equals its gross amount, and the lines of an Invoice sum to its total.
+package tax
+
+import (
+	"fmt"
+
+	"github.com/zenazn/money"
+	"github.com/zenazn/money/decimal"
+)
+
+// Tax is a tax levied at a fixed rate.
+type Tax struct {
+	Name string
+	Rate decimal.Rate
+	// Compound taxes are levied on the net amount plus all the taxes that
+	// precede them, like Quebec's QST used to be levied on top of GST.
+	// Other taxes are levied on the net amount alone.
+	Compound bool
+}
+
+// Rounding determines when taxes on an invoice are rounded.
+type Rounding int
+
+const (
+	// PerLine rounds the taxes on each line, and sums the rounded taxes
+	// to compute the invoice's taxes.
+	PerLine Rounding = iota
+	// PerInvoice rounds the taxes on the invoice as a whole. The taxes on
+	// each line are chosen so that they sum to the invoice's taxes, and
+	// differ from the line's exact tax by less than one minor unit.
+	PerInvoice
+)
+
+// maxTaxes is the maximum number of taxes a Calculator may levy, which keeps
+// its exact intermediate results in range.
+const maxTaxes = 5
+
+// maxRate is the maximum rate of a tax, 1000%.
+const maxRate = 10000000
+
+// Calculator computes taxes on prices.
+type Calculator struct {
+	// Taxes are the taxes to levy, in order. There may be at most 5 of
+	// them, with rates between 0% and 1000%.
+	Taxes []Tax
+	// Inclusive is true if prices include taxes. Tax-inclusive prices are
+	// the gross amounts of their Breakdowns, and tax-exclusive prices are
+	// the net amounts.
+	Inclusive bool
+	Rounding  Rounding
+	// Mode is the rounding mode used to round taxes to minor units.
+	Mode decimal.RoundingMode
+}
+
+// Breakdown splits an amount into its net amount and taxes.
+type Breakdown struct {
+	Net money.Money
+	// Taxes contains the amount of each of the Calculator's taxes, in
+	// order.
+	Taxes []money.Money
+	Gross money.Money
+}
+
+// Tax returns the sum of the taxes in the breakdown.
+func (b Breakdown) Tax() money.Money {
+	// Breakdowns never have mixed currencies
+	t, _ := money.Sum(b.Taxes...)
+	return t
+}
+
+// Invoice is the breakdown of each line of an invoice, and of its total.
+type Invoice struct {
+	Lines []Breakdown
+	Total Breakdown
+}
+
+// multipliers returns the tax levied on each unit of net amount by each tax, as
+// numerators over a common denominator.
+func (c Calculator) multipliers() ([]decimal.Decimal, decimal.Decimal, error) {
+	if len(c.Taxes) > maxTaxes {
+		return nil, decimal.Decimal{}, fmt.Errorf("tax: too many taxes (%d)", len(c.Taxes))
+	}
+	million := decimal.FromI64(1000000)
+	one := decimal.FromI64(1)
+
+	// With a denominator of 1000000**len(taxes), every multiplier is an
+	// exact multiple of 1/den.
+	den := one
+	for range c.Taxes {
+		den = den.MulDiv(million, one, 0, decimal.RoundDown)
+	}
+
+	nums := make([]decimal.Decimal, len(c.Taxes))
+	var sum decimal.Decimal
+	for i, t := range c.Taxes {
+		if ppm := t.Rate.PPM(); ppm < 0 || ppm > maxRate {
+			return nil, decimal.Decimal{}, fmt.Errorf("tax: %s rate is out of range", t.Name)
+		}
+		base := den
+		if t.Compound {
+			base = base.Add(sum)
+		}
+		nums[i] = base.MulDiv(decimal.FromI64(t.Rate.PPM()), million, 0, decimal.RoundDown)
+		sum = sum.Add(nums[i])
+	}
+
+	if c.Inclusive {
+		// Taxes are levied on net = gross / (1 + sum/den)
+		den = den.Add(sum)
+	}
+	return nums, den, nil
+}
+
+// Line returns the breakdown of a single price.
+func (c Calculator) Line(price money.Money) (Breakdown, error) {
+	inv, err := c.Invoice([]money.Money{price})
+	if err != nil {
+		return Breakdown{}, err
+	}
+	return inv.Lines[0], nil
+}
+
+// Invoice returns the breakdown of each of the given prices, and of their
+// total. It returns an error if the prices have different currencies, or if
+// the Calculator's taxes are invalid.
+func (c Calculator) Invoice(prices []money.Money) (Invoice, error) {
+	nums, den, err := c.multipliers()
+	if err != nil {
+		return Invoice{}, err
+	}
+	if c.Rounding != PerLine && c.Rounding != PerInvoice {
+		return Invoice{}, fmt.Errorf("tax: unknown rounding %d", int(c.Rounding))
+	}
+	if _, err := money.Sum(prices...); err != nil {
+		return Invoice{}, err
+	}
+
+	inv := Invoice{
+		Lines: make([]Breakdown, len(prices)),
+		Total: Breakdown{Taxes: make([]money.Money, len(nums))},
+	}
+	// With per-invoice rounding, the taxes on each line are the
+	// difference between the rounded taxes on the running total of the
+	// prices before and after it.
+	var running money.Money
+	for l, price := range prices {
+		b := Breakdown{Taxes: make([]money.Money, len(nums))}
+		prev := running
+		running = running.Add(price)
+		var tax money.Money
+		for i, num := range nums {
+			if c.Rounding == PerLine {
+				b.Taxes[i] = price.MulDivRound(num, den, c.Mode)
+			} else {
+				after := running.MulDivRound(num, den, c.Mode)
+				before := prev.MulDivRound(num, den, c.Mode)
+				b.Taxes[i] = after.Sub(before)
+			}
+			tax = tax.Add(b.Taxes[i])
+			inv.Total.Taxes[i] = inv.Total.Taxes[i].Add(b.Taxes[i])
+		}
+
+		if c.Inclusive {
+			b.Gross = price
+			b.Net = price.Sub(tax)
+		} else {
+			b.Net = price
+			b.Gross = price.Add(tax)
+		}
+		inv.Total.Net = inv.Total.Net.Add(b.Net)
+		inv.Total.Gross = inv.Total.Gross.Add(b.Gross)
+		inv.Lines[l] = b
+	}
+	return inv, nil
+}
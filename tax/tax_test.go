@@ -0,0 +1,150 @@
+package tax
+
+import (
+	"testing"
+
+	"github.com/zenazn/money"
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+func usd(cents int64) money.Money {
+	return money.FromMinorUnits(cents, currency.USD)
+}
+
+var (
+	vat = []Tax{{"VAT", decimal.NewRate(200000), false}}
+	gst = Tax{"GST", decimal.NewRate(50000), false}
+	qst = Tax{"QST", decimal.NewRate(95000), true}
+	pst = Tax{"PST", decimal.NewRate(70000), false}
+)
+
+var lineTests = []struct {
+	c          Calculator
+	price      money.Money
+	net, gross string
+	taxes      []string
+}{
+	{Calculator{Taxes: vat}, usd(10000), "USD 100.00", "USD 120.00", []string{"USD 20.00"}},
+	{Calculator{Taxes: vat, Inclusive: true}, usd(12000), "USD 100.00", "USD 120.00", []string{"USD 20.00"}},
+	{Calculator{Taxes: vat, Inclusive: true}, usd(1000), "USD 8.33", "USD 10.00", []string{"USD 1.67"}},
+	{Calculator{Taxes: []Tax{gst, qst}}, usd(10000), "USD 100.00", "USD 114.98", []string{"USD 5.00", "USD 9.98"}},
+	{Calculator{Taxes: []Tax{gst, qst}, Inclusive: true}, usd(11498), "USD 100.00", "USD 114.98", []string{"USD 5.00", "USD 9.98"}},
+	{Calculator{Taxes: []Tax{gst, pst}}, usd(1999), "USD 19.99", "USD 22.39", []string{"USD 1.00", "USD 1.40"}},
+	{Calculator{Taxes: []Tax{gst, pst}, Mode: decimal.RoundDown}, usd(1999), "USD 19.99", "USD 22.37", []string{"USD 0.99", "USD 1.39"}},
+	{Calculator{Taxes: vat}, usd(-1000), "USD -10.00", "USD -12.00", []string{"USD -2.00"}},
+	{Calculator{}, usd(1000), "USD 10.00", "USD 10.00", []string{}},
+	{Calculator{Taxes: vat}, money.Money{}, "0", "0", []string{"0"}},
+}
+
+func TestLine(t *testing.T) {
+	for i, test := range lineTests {
+		b, err := test.c.Line(test.price)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+			continue
+		}
+		if s := b.Net.String(); s != test.net {
+			t.Errorf("[%d] expected net %q got %q", i, test.net, s)
+		}
+		if s := b.Gross.String(); s != test.gross {
+			t.Errorf("[%d] expected gross %q got %q", i, test.gross, s)
+		}
+		if len(b.Taxes) != len(test.taxes) {
+			t.Errorf("[%d] expected %d taxes got %d", i, len(test.taxes), len(b.Taxes))
+			continue
+		}
+		for j, tax := range b.Taxes {
+			if s := tax.String(); s != test.taxes[j] {
+				t.Errorf("[%d] expected tax %d %q got %q", i, j, test.taxes[j], s)
+			}
+		}
+		if sum := b.Net.Add(b.Tax()); !sum.Eq(b.Gross) {
+			t.Errorf("[%d] net plus tax %v is not gross %v", i, sum, b.Gross)
+		}
+	}
+}
+
+var invoiceTests = []struct {
+	rounding  Rounding
+	inclusive bool
+	lines     []string
+	total     string
+}{
+	{PerLine, false, []string{"USD 0.00", "USD 0.00", "USD 0.00"}, "USD 0.00"},
+	{PerInvoice, false, []string{"USD 0.00", "USD 0.01", "USD 0.01"}, "USD 0.02"},
+	{PerLine, true, []string{"USD 0.00", "USD 0.00", "USD 0.00"}, "USD 0.00"},
+	{PerInvoice, true, []string{"USD 0.00", "USD 0.01", "USD 0.00"}, "USD 0.01"},
+}
+
+func TestInvoice(t *testing.T) {
+	prices := []money.Money{usd(10), usd(10), usd(10)}
+	for i, test := range invoiceTests {
+		c := Calculator{
+			Taxes:     []Tax{{"Sales tax", decimal.NewRate(50000), false}},
+			Inclusive: test.inclusive,
+			Rounding:  test.rounding,
+		}
+		inv, err := c.Invoice(prices)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, l := range inv.Lines {
+			if s := l.Tax().String(); s != test.lines[j] {
+				t.Errorf("[%d] expected line %d tax %q got %q", i, j, test.lines[j], s)
+			}
+		}
+		if s := inv.Total.Tax().String(); s != test.total {
+			t.Errorf("[%d] expected total tax %q got %q", i, test.total, s)
+		}
+		if sum := inv.Total.Net.Add(inv.Total.Tax()); !sum.Eq(inv.Total.Gross) {
+			t.Errorf("[%d] net plus tax %v is not gross %v", i, sum, inv.Total.Gross)
+		}
+	}
+}
+
+func TestInvoiceSums(t *testing.T) {
+	prices := []money.Money{usd(1999), usd(4999), usd(1), usd(333), usd(-500), usd(12345)}
+	for _, r := range []Rounding{PerLine, PerInvoice} {
+		for _, inclusive := range []bool{false, true} {
+			c := Calculator{Taxes: []Tax{gst, qst, pst}, Inclusive: inclusive, Rounding: r}
+			inv, err := c.Invoice(prices)
+			if err != nil {
+				t.Fatal(err)
+			}
+			net, gross := usd(0), usd(0)
+			taxes := []money.Money{usd(0), usd(0), usd(0)}
+			for _, l := range inv.Lines {
+				net, gross = net.Add(l.Net), gross.Add(l.Gross)
+				for j := range taxes {
+					taxes[j] = taxes[j].Add(l.Taxes[j])
+				}
+			}
+			if !net.Eq(inv.Total.Net) || !gross.Eq(inv.Total.Gross) {
+				t.Errorf("%v/%v: lines sum to %v/%v, not %v/%v", r, inclusive, net, gross, inv.Total.Net, inv.Total.Gross)
+			}
+			for j := range taxes {
+				if !taxes[j].Eq(inv.Total.Taxes[j]) {
+					t.Errorf("%v/%v: tax %d lines sum to %v, not %v", r, inclusive, j, taxes[j], inv.Total.Taxes[j])
+				}
+			}
+		}
+	}
+}
+
+func TestInvoiceErrors(t *testing.T) {
+	prices := []money.Money{usd(100), money.FromMinorUnits(100, currency.EUR)}
+	if _, err := (Calculator{Taxes: vat}).Invoice(prices); err == nil {
+		t.Error("expected error for mixed currencies")
+	}
+	if _, err := (Calculator{Taxes: make([]Tax, 6)}).Line(usd(100)); err == nil {
+		t.Error("expected error for too many taxes")
+	}
+	bad := []Tax{{"Bad", decimal.NewRate(-1), false}}
+	if _, err := (Calculator{Taxes: bad}).Line(usd(100)); err == nil {
+		t.Error("expected error for negative rate")
+	}
+	if _, err := (Calculator{Rounding: Rounding(7)}).Line(usd(100)); err == nil {
+		t.Error("expected error for unknown rounding")
+	}
+}
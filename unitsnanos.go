@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+// nanosPerUnit is the number of nanos in one major unit.
+const nanosPerUnit = 1000000000
+
+// pow10 returns 10**n as a Decimal.
+func pow10(n int) decimal.Decimal {
+	d := decimal.FromI64(1)
+	for ; n > 0; n-- {
+		d = d.Mul(ten)
+	}
+	return d
+}
+
+// ToUnitsNanos splits the value into the fields of the google.type.Money
+// protocol buffer message: its currency code, the whole number of major units
+// in it, and the number of nano (10**-9) units in the remainder. Like in
+// google.type.Money, units and nanos have the same sign, so -1.75 is -1 units
+// and -750,000,000 nanos. The currencyless zero has an empty currency code.
+//
+// ToUnitsNanos returns an error if the value's currency has no ISO 4217 code,
+// which google.type.Money requires and FromUnitsNanos accepts, if the value is
+// more precise than nanos (use Round to discard the extra precision first), or
+// if it has too many units for an int64.
+func ToUnitsNanos(m Money) (code string, units int64, nanos int32, err error) {
+	if m.ccy == nil {
+		return "", 0, 0, nil
+	} else if _, ok := currency.ISONumber(m.ccy); !ok {
+		return "", 0, 0, fmt.Errorf("money: %s isn't an ISO 4217 currency", m.ccy.Symbol())
+	}
+	one, scale := decimal.FromI64(1), pow10(int(m.ccy.Units().MajorUnitScalingFactorExponent))
+
+	// Both parts are truncated towards zero, so they share a sign
+	u := m.amt.MulDiv(one, scale, 0, decimal.RoundDown)
+	units, ok := u.Int64()
+	if !ok {
+		return "", 0, 0, fmt.Errorf("money: %s has too many units for an int64", m)
+	}
+	rest := m.amt.Sub(u.MulDiv(scale, one, 0, decimal.RoundDown))
+	billion := decimal.FromI64(nanosPerUnit)
+	n := rest.MulDiv(billion, scale, 0, decimal.RoundDown)
+	if n != rest.MulDiv(billion, scale, 0, decimal.RoundUp) {
+		return "", 0, 0, fmt.Errorf("money: %s is more precise than nanos", m)
+	}
+	n64, _ := n.Int64()
+	return m.ccy.Symbol(), units, int32(n64), nil
+}
+
+// FromUnitsNanos returns the value of the fields of a google.type.Money
+// protocol buffer message: an ISO 4217 currency code, a whole number of major
+// units, and a number of nano (10**-9) units. An empty currency code with zero
+// units and nanos is the currencyless zero.
+//
+// FromUnitsNanos returns an error if the currency is unknown, if nanos is
+// outside the range of -999,999,999 to 999,999,999, if units and nanos have
+// different signs, or if nanos is more precise than the currency's
+// representation.
+func FromUnitsNanos(code string, units int64, nanos int32) (Money, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return Money{}, fmt.Errorf("money: nanos %d out of range", nanos)
+	} else if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, fmt.Errorf("money: units %d and nanos %d have different signs", units, nanos)
+	}
+	if code == "" && units == 0 && nanos == 0 {
+		return Money{}, nil
+	}
+	c, err := currency.FromISOSymbol(code)
+	if err != nil {
+		return Money{}, err
+	}
+
+	one, scale := decimal.FromI64(1), pow10(int(c.Units().MajorUnitScalingFactorExponent))
+	billion := decimal.FromI64(nanosPerUnit)
+	n := decimal.FromI64(int64(nanos)).MulDiv(scale, billion, 0, decimal.RoundDown)
+	if n != decimal.FromI64(int64(nanos)).MulDiv(scale, billion, 0, decimal.RoundUp) {
+		return Money{}, fmt.Errorf("money: nanos %d too precise for %s", nanos, code)
+	}
+	amt := decimal.FromI64(units).MulDiv(scale, one, 0, decimal.RoundDown)
+	return Money{amt.Add(n), c}, nil
+}
@@ -0,0 +1,83 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/zenazn/money/currency"
+	"github.com/zenazn/money/decimal"
+)
+
+var unitsNanosTests = []struct {
+	m     Money
+	code  string
+	units int64
+	nanos int32
+}{
+	{Money{}, "", 0, 0},
+	{usd(175), "USD", 1, 750000000},
+	{usd(-175), "USD", -1, -750000000},
+	{usd(-50), "USD", 0, -500000000},
+	{mustparse("0.000001", "USD"), "USD", 0, 1000},
+	{mustparse("5", "JPY"), "JPY", 5, 0},
+	{Zero(currency.EUR), "EUR", 0, 0},
+	{mustparse("9223372036854775807.999999", "USD"), "USD", 9223372036854775807, 999999000},
+	{mustparse("9223372036854775808", "USD").Neg(), "USD", -9223372036854775808, 0},
+}
+
+func TestToUnitsNanos(t *testing.T) {
+	for i, test := range unitsNanosTests {
+		code, units, nanos, err := ToUnitsNanos(test.m)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if code != test.code || units != test.units || nanos != test.nanos {
+			t.Errorf("[%d] expected %q %d %d got %q %d %d", i, test.code, test.units, test.nanos, code, units, nanos)
+		}
+	}
+
+	for i, m := range []Money{
+		New(decimal.FromI64(1), precise(12)),
+		New(decimal.FromI64(1000), precise(3)),
+		btc(1),
+		mustparse("9223372036854775808", "USD"),
+		mustparse("9223372036854775809", "USD").Neg(),
+	} {
+		if code, units, nanos, err := ToUnitsNanos(m); err == nil {
+			t.Errorf("[%d] expected error got %q %d %d", i, code, units, nanos)
+		}
+	}
+}
+
+func TestFromUnitsNanos(t *testing.T) {
+	for i, test := range unitsNanosTests {
+		m, err := FromUnitsNanos(test.code, test.units, test.nanos)
+		if err != nil {
+			t.Errorf("[%d] unexpected error %v", i, err)
+		} else if m != test.m {
+			t.Errorf("[%d] expected %v got %v", i, test.m, m)
+		}
+	}
+}
+
+var fromUnitsNanosFailureTests = []struct {
+	code  string
+	units int64
+	nanos int32
+}{
+	{"USD", 0, 1000000000},
+	{"USD", 0, -1000000000},
+	{"USD", 1, -1},
+	{"USD", -1, 1},
+	{"USD", 0, 1},
+	{"USD", 1, 999999999},
+	{"usd", 1, 0},
+	{"", 1, 0},
+	{"", 0, 1000},
+}
+
+func TestFromUnitsNanosFailures(t *testing.T) {
+	for i, test := range fromUnitsNanosFailureTests {
+		if m, err := FromUnitsNanos(test.code, test.units, test.nanos); err == nil {
+			t.Errorf("[%d] unexpectedly passed: %v", i, m)
+		}
+	}
+}